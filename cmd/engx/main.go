@@ -33,6 +33,10 @@ Focus: Terminal UI/UX patterns, not actual application scaffolding.`,
 
 	// Other global flags
 	rootCmd.PersistentFlags().String("config", "", "Config file (default searches for .engx/config.yaml)")
+	rootCmd.PersistentFlags().String("color", "auto", "Color output: auto, always, never")
+	rootCmd.PersistentFlags().String("output", "text", "Progress output: text (TUI), json (newline-delimited events for automation)")
+	rootCmd.PersistentFlags().String("glyphs", "unicode", "Status icon glyphs: unicode, ascii (for terminals without a checkmark)")
+	rootCmd.PersistentFlags().Bool("verbose-errors", false, "On failure, print the full wrapped error chain for bug reports (implied by --debug)")
 
 	// Mark verbosity flags as mutually exclusive
 	rootCmd.MarkFlagsMutuallyExclusive("quiet", "concise", "verbose", "debug")
@@ -40,9 +44,18 @@ Focus: Terminal UI/UX patterns, not actual application scaffolding.`,
 	// Add commands
 	rootCmd.AddCommand(commands.NewCreateCommand())
 	rootCmd.AddCommand(commands.NewTestErrorCommand())
+	rootCmd.AddCommand(commands.NewErrorsCommand())
+	rootCmd.AddCommand(commands.NewDefaultsCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+
+		// Bug reports need every wrapped cause, not just the top-level message
+		verboseErrors, _ := rootCmd.PersistentFlags().GetBool("verbose-errors")
+		debug, _ := rootCmd.PersistentFlags().GetBool("debug")
+		if verboseErrors || debug {
+			fmt.Fprintf(os.Stderr, "\nError chain:\n%s", commands.FormatErrorChain(err))
+		}
 		os.Exit(1)
 	}
 }
\ No newline at end of file
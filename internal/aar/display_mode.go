@@ -0,0 +1,43 @@
+package aar
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DisplayMode controls whether the AAR prints after a run, independent of verbosity
+type DisplayMode int
+
+const (
+	DisplayOn   DisplayMode = iota // Live animation, then the AAR
+	DisplayOff                     // Live animation only
+	DisplayOnly                    // AAR only, no animation
+)
+
+// String returns the flag value for the display mode
+func (d DisplayMode) String() string {
+	switch d {
+	case DisplayOn:
+		return "on"
+	case DisplayOff:
+		return "off"
+	case DisplayOnly:
+		return "only"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDisplayMode parses an --aar value
+func ParseDisplayMode(s string) (DisplayMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "on", "":
+		return DisplayOn, nil
+	case "off":
+		return DisplayOff, nil
+	case "only":
+		return DisplayOnly, nil
+	default:
+		return DisplayOn, fmt.Errorf("invalid aar: %s (expected on, off, only)", s)
+	}
+}
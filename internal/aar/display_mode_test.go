@@ -0,0 +1,34 @@
+package aar
+
+import "testing"
+
+func TestParseDisplayMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    DisplayMode
+		wantErr bool
+	}{
+		{input: "", want: DisplayOn},
+		{input: "on", want: DisplayOn},
+		{input: " OFF ", want: DisplayOff},
+		{input: "only", want: DisplayOnly},
+		{input: "summary", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDisplayMode(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDisplayMode(%q) error = %v, wantErr %t", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && got != tt.want {
+			t.Errorf("ParseDisplayMode(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+
+	for _, mode := range []DisplayMode{DisplayOn, DisplayOff, DisplayOnly} {
+		if got, err := ParseDisplayMode(mode.String()); err != nil || got != mode {
+			t.Errorf("ParseDisplayMode(%q) = %s, %v, want it to round-trip", mode.String(), got, err)
+		}
+	}
+}
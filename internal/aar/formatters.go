@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -15,26 +16,56 @@ type OutputFormatter interface {
 
 // StandardFormatter provides the default AAR output format
 type StandardFormatter struct {
-	width int
+	width        int
+	colorEnabled bool
+	separator    rune
+	theme        *styles.Theme
 }
 
-// NewStandardFormatter creates a new standard formatter
+// AAR layout width bounds
+const (
+	defaultAARWidth = 80  // Used when no usable width is available
+	maxAARWidth     = 120 // Wider terminals fall back to the default
+)
+
+// NewStandardFormatter creates a new standard formatter.
+// This is the single place the AAR width is clamped; Format uses it as given.
 func NewStandardFormatter(width int) *StandardFormatter {
-	if width <= 0 {
-		width = 80 // Default width
+	if width <= 0 || width > maxAARWidth {
+		width = defaultAARWidth
+	}
+	return &StandardFormatter{width: width, colorEnabled: true, separator: styles.DefaultSeparator, theme: styles.DefaultTheme()}
+}
+
+// SetTheme sets the palette used for colored output (nil = styles.DefaultTheme)
+func (f *StandardFormatter) SetTheme(theme *styles.Theme) {
+	if theme == nil {
+		theme = styles.DefaultTheme()
 	}
-	return &StandardFormatter{width: width}
+	f.theme = theme
+}
+
+// SetSeparator sets the rune used for the header and footer dash runs
+func (f *StandardFormatter) SetSeparator(sep rune) {
+	f.separator = sep
+}
+
+// dashes returns a run of n separator runes
+func (f *StandardFormatter) dashes(n int) string {
+	return strings.Repeat(string(f.separator), n)
+}
+
+// SetColorEnabled controls whether the AAR output contains ANSI colors
+func (f *StandardFormatter) SetColorEnabled(enabled bool) {
+	f.colorEnabled = enabled
 }
 
 // Format generates the standard AAR output using the specified template with terminal-width awareness and styling
 func (f *StandardFormatter) Format(summary *AARSummary) string {
 	var output strings.Builder
 
-	// Use terminal width, with sensible defaults
+	// Width is already clamped by the constructor
 	width := f.width
-	if width <= 0 || width > 120 {
-		width = 80 // Reasonable default
-	}
 
 	// Calculate total duration
 	duration := summary.ExecutionInfo.EndTime.Sub(summary.ExecutionInfo.StartTime)
@@ -69,29 +100,17 @@ func (f *StandardFormatter) Format(summary *AARSummary) string {
 	// Create text components (NO STYLING YET - SPACING ONLY)
 	headerText := "AFTER ACTION SUMMARY"
 	successText := "OPERATION SUCCESS"
-	footerSteps := fmt.Sprintf("%d/%d Steps Completed", len(summary.StepResults), len(summary.StepResults))
+	if summary.ExecutionInfo.FailedSteps > 0 {
+		successText = "OPERATION COMPLETED WITH ERRORS"
+	}
+	footerSteps := fmt.Sprintf("%d/%d Steps Completed", summary.ExecutionInfo.SuccessSteps, summary.ExecutionInfo.TotalSteps)
 	footerTime := fmt.Sprintf("Total Elapsed time: %s", durationStr)
 
 	// Calculate spacing for full-width layout
-	headerPadding := width - len(headerText) - len(successText) - 12 // Account for dashes, spaces, and margin
-	if headerPadding < 1 {
-		headerPadding = 1
-	}
-
-	footerPadding := width - len(footerSteps) - len(footerTime) - 12 // Account for dashes, spaces, and margin
-	if footerPadding < 1 {
-		footerPadding = 1
-	}
+	headerPadding := styles.GapWidth(headerText, successText, width-12) // Account for dashes, spaces, and margin
+	footerPadding := styles.GapWidth(footerSteps, footerTime, width-12) // Account for dashes, spaces, and margin
 
-	// ANSI color codes - exact same as progress table enhanced_renderer.go:10-23
-	const (
-		colorReset         = "\033[0m"
-		colorWhite         = "\033[97m"  // Bright white
-		colorGreen         = "\033[92m"  // Green for OPERATION SUCCESS (matches [installed])
-		colorLightGrey     = "\033[90m"  // Darker grey for dashes
-		colorBrightOrange  = "\033[38;5;208m"  // Bright orange for PRODUCTION READY
-		colorBrightMagenta = "\033[95m"  // Bright magenta for terminal commands
-	)
+	t := f.theme
 
 	// formatCommandsInBackticks applies magenta color to text wrapped in backticks
 	// while preserving the surrounding color context
@@ -112,66 +131,178 @@ func (f *StandardFormatter) Format(summary *AARSummary) string {
 			// Extract command without backticks
 			command := result[start+1:end]
 			// Replace with colored version that returns to grey
-			coloredCommand := fmt.Sprintf("%s%s%s%s", colorBrightMagenta, command, colorReset, colorLightGrey)
+			coloredCommand := fmt.Sprintf("%s%s%s%s", t.AppName, command, t.Reset, t.Dashes)
 			result = result[:start] + coloredCommand + result[end+1:]
 		}
 		return result
 	}
 
+	// Outcome color for the header status
+	outcomeColor := t.Done
+	if summary.ExecutionInfo.FailedSteps > 0 {
+		outcomeColor = t.Warning
+	}
+
 	// Build the AAR with exact spacing AND COLORS
 	output.WriteString("\n")
 
 	// Header line - exact template format with colors
-	output.WriteString(fmt.Sprintf("%s----%s %s%s%s %s%s%s %s%s%s %s----%s\n",
-		colorLightGrey, colorReset,  // Grey dashes
-		colorWhite, headerText, colorReset,  // White title
-		colorLightGrey, strings.Repeat("-", headerPadding), colorReset,  // Grey middle dashes
-		colorGreen, successText, colorReset,  // Green OPERATION SUCCESS
-		colorLightGrey, colorReset))  // Grey end dashes
+	output.WriteString(fmt.Sprintf("%s%s%s %s%s%s %s%s%s %s%s%s %s%s%s\n",
+		t.Dashes, f.dashes(4), t.Reset,  // Grey dashes
+		t.Text, headerText, t.Reset,  // White title
+		t.Dashes, f.dashes(headerPadding), t.Reset,  // Grey middle dashes
+		outcomeColor, successText, t.Reset,  // Green success or yellow degraded outcome
+		t.Dashes, f.dashes(4), t.Reset))  // Grey end dashes
 
 	output.WriteString("  \n") // Empty line with leading spaces
 
 	// Action items section - exact spacing from template with colors
-	output.WriteString(fmt.Sprintf("  %sYou can now:%s\n", colorWhite, colorReset))
+	output.WriteString(fmt.Sprintf("  %sYou can now:%s\n", t.Text, t.Reset))
 	output.WriteString(fmt.Sprintf("   %sLaunch your DEV server:%s      %s%s%s\n",
-		colorLightGrey, colorReset, colorWhite, devCommand, colorReset))
+		t.Dashes, t.Reset, t.Text, devCommand, t.Reset))
 	output.WriteString(fmt.Sprintf("   %sOpen in your editor:%s         %scode %s%s\n",
-		colorLightGrey, colorReset, colorWhite, summary.ProjectInfo.Name, colorReset))
+		t.Dashes, t.Reset, t.Text, summary.ProjectInfo.Name, t.Reset))
 	output.WriteString("\n")
 
 	// Setup status section with colors
 	setupTypeColored := setupType
 	if isDevOnly {
-		setupTypeColored = fmt.Sprintf("%s%s%s", colorBrightOrange, setupType, colorReset)
+		setupTypeColored = fmt.Sprintf("%s%s%s", t.Highlight, setupType, t.Reset)
 	} else {
-		setupTypeColored = fmt.Sprintf("%s%s%s", colorBrightOrange, setupType, colorReset)
+		setupTypeColored = fmt.Sprintf("%s%s%s", t.Highlight, setupType, t.Reset)
 	}
 	output.WriteString(fmt.Sprintf("  %sThis application is set up to be%s %s.\n",
-		colorWhite, colorReset, setupTypeColored))
+		t.Text, t.Reset, setupTypeColored))
 
 	if setupNote != "" {
 		// Apply magenta formatting to commands in backticks
 		formattedSetupNote := formatCommandsInBackticks(setupNote)
-		output.WriteString(fmt.Sprintf("%s%s%s\n", colorLightGrey, formattedSetupNote, colorReset))
+		output.WriteString(fmt.Sprintf("%s%s%s\n", t.Dashes, formattedSetupNote, t.Reset))
+	}
+
+	// Note optional prompts that were skipped in favour of defaults
+	if config := summary.ProjectInfo.Configuration; config != nil {
+		for _, skipped := range config.SkippedPrompts {
+			output.WriteString(fmt.Sprintf("   %s└ %s skipped (using defaults)%s\n",
+				t.Dashes, skipped, t.Reset))
+		}
 	}
 
 	// Local server info section with colors
 	output.WriteString(fmt.Sprintf("\n  %sOnce running, your development server will be available at:%s\n",
-		colorLightGrey, colorReset))
+		t.Dashes, t.Reset))
 	output.WriteString(fmt.Sprintf("   %s└%s %shttp://localhost:%s%s\n\n",
-		colorLightGrey, colorReset, colorWhite, port, colorReset))
+		t.Dashes, t.Reset, t.Text, port, t.Reset))
+
+	// Configuration warnings that applied to this run
+	if len(summary.Warnings) > 0 {
+		output.WriteString(fmt.Sprintf("  %sConfiguration warnings:%s\n", t.Text, t.Reset))
+		for _, warning := range summary.Warnings {
+			output.WriteString(fmt.Sprintf("   %s└ ⚠ %s%s\n", t.Warning, warning, t.Reset))
+		}
+		output.WriteString("\n")
+	}
+
+	// How chaos difficulty adapted during the run
+	if len(summary.ChaosDifficulty) > 0 {
+		output.WriteString(fmt.Sprintf("  %sChaos difficulty:%s\n", t.Text, t.Reset))
+		for _, line := range summary.ChaosDifficulty {
+			output.WriteString(fmt.Sprintf("   %s└ %s%s\n", t.Dashes, line, t.Reset))
+		}
+		output.WriteString("\n")
+	}
+
+	// Estimated vs actual comparison
+	if summary.ExecutionInfo.EstimatedDuration > 0 {
+		output.WriteString(fmt.Sprintf("  %s%s%s\n\n",
+			t.Dashes, f.formatEstimateComparison(summary.ExecutionInfo), t.Reset))
+	}
+
+	// Real vs simulated time, e.g. for headless runs that skip the animation
+	if summary.ExecutionInfo.SimulatedTimeDiffers() {
+		output.WriteString(fmt.Sprintf("  %sWall clock %s, simulated %s%s\n\n",
+			t.Dashes, f.formatDuration(summary.ExecutionInfo.Duration),
+			f.formatDuration(summary.ExecutionInfo.SimulatedDuration), t.Reset))
+	}
 
 	// Footer line - exact template format with colors
-	output.WriteString(fmt.Sprintf("%s----%s %s%s%s %s%s%s %s%s%s %s----%s\n",
-		colorLightGrey, colorReset,  // Grey dashes
-		colorWhite, footerSteps, colorReset,  // White steps
-		colorLightGrey, strings.Repeat("-", footerPadding), colorReset,  // Grey middle dashes
-		colorWhite, footerTime, colorReset,  // White time
-		colorLightGrey, colorReset))  // Grey end dashes
+	output.WriteString(fmt.Sprintf("%s%s%s %s%s%s %s%s%s %s%s%s %s%s%s\n",
+		t.Dashes, f.dashes(4), t.Reset,  // Grey dashes
+		t.Text, footerSteps, t.Reset,  // White steps
+		t.Dashes, f.dashes(footerPadding), t.Reset,  // Grey middle dashes
+		t.Text, footerTime, t.Reset,  // White time
+		t.Dashes, f.dashes(4), t.Reset))  // Grey end dashes
+
+	if !f.colorEnabled {
+		return styles.StripANSI(output.String())
+	}
+	return output.String()
+}
+
+// CompactFormatter renders a few-line AAR: outcome header, step count,
+// duration and the single most important next step
+type CompactFormatter struct {
+	*StandardFormatter
+}
+
+// NewCompactFormatter creates a compact formatter sharing the standard layout settings
+func NewCompactFormatter(width int) *CompactFormatter {
+	return &CompactFormatter{StandardFormatter: NewStandardFormatter(width)}
+}
+
+// Format renders the compact AAR, omitting resources and troubleshooting
+func (f *CompactFormatter) Format(summary *AARSummary) string {
+	t := f.theme
+
+	var output strings.Builder
+
+	headerText := "AFTER ACTION SUMMARY"
+	outcomeText := "OPERATION SUCCESS"
+	outcomeColor := t.Done
+	if summary.ExecutionInfo.FailedSteps > 0 {
+		outcomeText = "OPERATION COMPLETED WITH ERRORS"
+		outcomeColor = t.Warning
+	}
+
+	headerPadding := styles.GapWidth(headerText, outcomeText, f.width-12)
+
+	output.WriteString(fmt.Sprintf("%s%s%s %s%s%s %s%s%s %s%s%s %s%s%s\n",
+		t.Dashes, f.dashes(4), t.Reset,
+		t.Text, headerText, t.Reset,
+		t.Dashes, f.dashes(headerPadding), t.Reset,
+		outcomeColor, outcomeText, t.Reset,
+		t.Dashes, f.dashes(4), t.Reset))
 
+	duration := summary.ExecutionInfo.EndTime.Sub(summary.ExecutionInfo.StartTime)
+	output.WriteString(fmt.Sprintf("  %s%d/%d steps completed in %s%s\n",
+		t.Text, summary.ExecutionInfo.SuccessSteps, summary.ExecutionInfo.TotalSteps,
+		f.formatMinutesSeconds(duration), t.Reset))
+
+	if next := topNextStep(summary.NextSteps); next != nil {
+		line := fmt.Sprintf("  %sNext: %s%s", t.Dashes, next.Action, t.Reset)
+		if next.Command != "" {
+			line += fmt.Sprintf("%s:%s %s%s%s", t.Dashes, t.Reset, t.AppName, next.Command, t.Reset)
+		}
+		output.WriteString(line + "\n")
+	}
+
+	if !f.colorEnabled {
+		return styles.StripANSI(output.String())
+	}
 	return output.String()
 }
 
+// topNextStep returns the highest-priority next step, keeping the first on ties
+func topNextStep(steps []NextStep) *NextStep {
+	var top *NextStep
+	for i := range steps {
+		if top == nil || steps[i].Priority > top.Priority {
+			top = &steps[i]
+		}
+	}
+	return top
+}
+
 // writeHeader writes the header section
 func (f *StandardFormatter) writeHeader(output *strings.Builder, summary *AARSummary) {
 	// Create header box
@@ -204,6 +335,9 @@ func (f *StandardFormatter) writeHeader(output *strings.Builder, summary *AARSum
 	}
 
 	projectInfo += fmt.Sprintf("\nDuration: %s", f.formatDuration(summary.ExecutionInfo.Duration))
+	if summary.ExecutionInfo.SimulatedTimeDiffers() {
+		projectInfo += fmt.Sprintf(" (simulated %s)", f.formatDuration(summary.ExecutionInfo.SimulatedDuration))
+	}
 
 	projectInfo += fmt.Sprintf("\nSteps: %d/%d completed successfully",
 		summary.ExecutionInfo.SuccessSteps,
@@ -417,6 +551,25 @@ func (f *StandardFormatter) formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%dm %ds", int(d.Minutes()), int(d.Seconds())%60)
 }
 
+// formatEstimateComparison describes how the actual run time compared to the estimate
+func (f *StandardFormatter) formatEstimateComparison(info ExecutionInfo) string {
+	comparison := fmt.Sprintf("Estimated %s, actual %s",
+		f.formatMinutesSeconds(info.EstimatedDuration), f.formatMinutesSeconds(info.Duration))
+
+	switch {
+	case info.EstimateDelta > 0:
+		comparison += fmt.Sprintf(" (%s faster)", f.formatMinutesSeconds(info.EstimateDelta))
+	case info.EstimateDelta < 0:
+		comparison += fmt.Sprintf(" (%s slower)", f.formatMinutesSeconds(-info.EstimateDelta))
+	}
+	return comparison
+}
+
+// formatMinutesSeconds formats a duration as "4m 30s"
+func (f *StandardFormatter) formatMinutesSeconds(d time.Duration) string {
+	return fmt.Sprintf("%dm %02ds", int(d.Minutes()), int(d.Seconds())%60)
+}
+
 func (f *StandardFormatter) hasPerformanceIssues(summary *AARSummary) bool {
 	targets := summary.ExecutionInfo.Performance.ConfigurableTargets
 
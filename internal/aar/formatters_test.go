@@ -0,0 +1,190 @@
+package aar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+// newTestSummary returns a minimal successful summary for formatter tests
+func newTestSummary() *AARSummary {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return &AARSummary{
+		ProjectInfo: ProjectInfo{Name: "TestApp", Template: "typescript", DevOnly: true},
+		ExecutionInfo: ExecutionInfo{
+			StartTime:    start,
+			EndTime:      start.Add(90 * time.Second),
+			Duration:     90 * time.Second,
+			TotalSteps:   3,
+			SuccessSteps: 3,
+		},
+	}
+}
+
+// lineContaining returns the first line of output containing substr
+func lineContaining(t *testing.T, output, substr string) string {
+	t.Helper()
+	for _, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, substr) {
+			return line
+		}
+	}
+	t.Fatalf("no line contains %q:\n%s", substr, output)
+	return ""
+}
+
+func TestStandardFormatterWidth(t *testing.T) {
+	tests := []struct {
+		width int
+		want  int
+	}{
+		{width: 100, want: 100},
+		{width: 120, want: 120},
+		{width: 0, want: defaultAARWidth},
+		{width: 200, want: defaultAARWidth},
+	}
+
+	for _, tt := range tests {
+		formatter := NewStandardFormatter(tt.width)
+		formatter.SetColorEnabled(false)
+		output := formatter.Format(newTestSummary())
+
+		header := lineContaining(t, output, "AFTER ACTION SUMMARY")
+		if got := styles.VisibleWidth(header); got != tt.want {
+			t.Errorf("width %d: header is %d columns, want %d: %q", tt.width, got, tt.want, header)
+		}
+		footer := lineContaining(t, output, "Steps Completed")
+		if got := styles.VisibleWidth(footer); got != tt.want {
+			t.Errorf("width %d: footer is %d columns, want %d: %q", tt.width, got, tt.want, footer)
+		}
+	}
+}
+
+func TestStandardFormatterNotesSkippedPrompts(t *testing.T) {
+	summary := newTestSummary()
+	summary.ProjectInfo.Configuration = &config.UserConfiguration{SkippedPrompts: []string{"Production Setup"}}
+
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+	output := formatter.Format(summary)
+
+	if !strings.Contains(output, "Production Setup skipped (using defaults)") {
+		t.Errorf("AAR does not note the skipped prompt:\n%s", output)
+	}
+}
+
+func TestStandardFormatterSeparatorRune(t *testing.T) {
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+	formatter.SetSeparator('─')
+	output := formatter.Format(newTestSummary())
+
+	header := lineContaining(t, output, "AFTER ACTION SUMMARY")
+	if !strings.Contains(header, "──") || strings.Contains(header, "--") {
+		t.Errorf("header does not use the configured separator: %q", header)
+	}
+	if got := styles.VisibleWidth(header); got != 100 {
+		t.Errorf("header is %d columns, want 100: %q", got, header)
+	}
+}
+
+func TestStandardFormatterOutcomeAware(t *testing.T) {
+	tests := []struct {
+		name          string
+		success       int
+		failed        int
+		wantOutcome   string
+		absentOutcome string
+		wantRatio     string
+	}{
+		{"all succeeded", 3, 0, "OPERATION SUCCESS", "OPERATION COMPLETED WITH ERRORS", "3/3 Steps Completed"},
+		{"one failed", 2, 1, "OPERATION COMPLETED WITH ERRORS", "OPERATION SUCCESS", "2/3 Steps Completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := newTestSummary()
+			summary.ExecutionInfo.SuccessSteps = tt.success
+			summary.ExecutionInfo.FailedSteps = tt.failed
+
+			formatter := NewStandardFormatter(100)
+			formatter.SetColorEnabled(false)
+			output := formatter.Format(summary)
+
+			if !strings.Contains(output, tt.wantOutcome) {
+				t.Errorf("output missing %q:\n%s", tt.wantOutcome, output)
+			}
+			if strings.Contains(output, tt.absentOutcome) {
+				t.Errorf("output unexpectedly contains %q", tt.absentOutcome)
+			}
+			lineContaining(t, output, tt.wantRatio)
+
+			formatter.SetColorEnabled(true)
+			wantColor := styles.DefaultTheme().Done
+			if tt.failed > 0 {
+				wantColor = styles.DefaultTheme().Warning
+			}
+			if colored := formatter.Format(summary); !strings.Contains(colored, wantColor+tt.wantOutcome) {
+				t.Errorf("outcome %q is not colored %q", tt.wantOutcome, wantColor)
+			}
+		})
+	}
+}
+
+func TestCompactFormatterOmitsLongSections(t *testing.T) {
+	summary := newTestSummary()
+	summary.NextSteps = []NextStep{
+		{Action: "Read the docs", Priority: PriorityLow},
+		{Action: "Start the dev server", Command: "npm run dev", Priority: PriorityHigh},
+	}
+	summary.Troubleshooting = &TroubleshootingInfo{Suggestions: []string{"Clear the npm cache"}}
+
+	formatter := NewCompactFormatter(100)
+	formatter.SetColorEnabled(false)
+	compact := formatter.Format(summary)
+
+	for _, section := range []string{"Learn More:", "Troubleshooting:", "Next Steps:", "Read the docs"} {
+		if strings.Contains(compact, section) {
+			t.Errorf("compact AAR contains %q:\n%s", section, compact)
+		}
+	}
+	lineContaining(t, compact, "AFTER ACTION SUMMARY")
+	lineContaining(t, compact, "3/3 steps completed in 1m 30s")
+	if line := lineContaining(t, compact, "Next:"); !strings.Contains(line, "Start the dev server: npm run dev") {
+		t.Errorf("top action line = %q, want the high-priority step", line)
+	}
+	if lines := strings.Count(compact, "\n"); lines > 4 {
+		t.Errorf("compact AAR is %d lines, want a few", lines)
+	}
+}
+
+func TestStandardFormatterShowsChaosDifficulty(t *testing.T) {
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+
+	summary := newTestSummary()
+	if output := formatter.Format(summary); strings.Contains(output, "Chaos difficulty:") {
+		t.Errorf("AAR shows a chaos difficulty block without any adaptations:\n%s", output)
+	}
+
+	summary.ChaosDifficulty = []string{
+		"▂▃  1 adaptation(s), started at default, ended at scout",
+		"+2s default → scout: high success rate",
+	}
+	output := formatter.Format(summary)
+	header := strings.Index(output, "Chaos difficulty:")
+	if header < 0 {
+		t.Fatalf("AAR is missing the chaos difficulty block:\n%s", output)
+	}
+	previous := header
+	for _, line := range summary.ChaosDifficulty {
+		at := strings.Index(output, "└ "+line)
+		if at < previous {
+			t.Errorf("difficulty line %q missing or out of order:\n%s", line, output)
+		}
+		previous = at
+	}
+}
@@ -16,6 +16,9 @@ type AARGenerator struct {
 	projectPath   string
 	stepResults   []StepResult
 	performanceTargets map[string]time.Duration
+	estimatedDuration  time.Duration
+	configWarnings     []string
+	chaosDifficulty    []string
 }
 
 // NewAARGenerator creates a new AAR generator
@@ -26,12 +29,12 @@ func NewAARGenerator(tracker *progresssim.Tracker, config *config.UserConfigurat
 		startTime:   startTime,
 		projectPath: projectPath,
 		stepResults: make([]StepResult, 0),
-		performanceTargets: getDefaultPerformanceTargets(),
+		performanceTargets: DefaultPerformanceTargets(),
 	}
 }
 
-// getDefaultPerformanceTargets returns configurable performance targets
-func getDefaultPerformanceTargets() map[string]time.Duration {
+// DefaultPerformanceTargets returns the built-in performance targets
+func DefaultPerformanceTargets() map[string]time.Duration {
 	return map[string]time.Duration{
 		"total_execution":     3 * time.Minute,    // Target: under 3 minutes total
 		"package_installation": 60 * time.Second,  // Target: under 1 minute for packages
@@ -50,11 +53,25 @@ func (g *AARGenerator) RecordStep(stepName string, status StepStatus, duration t
 		StartTime:    time.Now().Add(-duration),
 		EndTime:      time.Now(),
 		ErrorMessage: errorMessage,
+		Tags:         g.stepTags(stepName),
 	}
 
 	g.stepResults = append(g.stepResults, result)
 }
 
+// MarkStepFailed flips the latest recorded result for a step to failed,
+// recording a new failed result if the step wasn't recorded yet
+func (g *AARGenerator) MarkStepFailed(stepName, errorMessage string) {
+	for i := len(g.stepResults) - 1; i >= 0; i-- {
+		if g.stepResults[i].Name == stepName {
+			g.stepResults[i].Status = StepStatusFailed
+			g.stepResults[i].ErrorMessage = errorMessage
+			return
+		}
+	}
+	g.RecordStep(stepName, StepStatusFailed, 0, errorMessage)
+}
+
 // RecordStepWithDetails records a step with additional details for verbose/debug modes
 func (g *AARGenerator) RecordStepWithDetails(stepName string, status StepStatus, duration time.Duration, errorMessage, details string, subSteps []SubStepResult) {
 	result := StepResult{
@@ -66,11 +83,38 @@ func (g *AARGenerator) RecordStepWithDetails(stepName string, status StepStatus,
 		ErrorMessage: errorMessage,
 		Details:      details,
 		SubSteps:     subSteps,
+		Tags:         g.stepTags(stepName),
 	}
 
 	g.stepResults = append(g.stepResults, result)
 }
 
+// stepTags looks up the categorization tags of a tracked step
+func (g *AARGenerator) stepTags(stepName string) []string {
+	if g.tracker == nil {
+		return nil
+	}
+	if step := g.tracker.GetStepByName(stepName); step != nil {
+		return step.Tags
+	}
+	return nil
+}
+
+// SetConfigWarnings records the configuration warnings shown before the run
+func (g *AARGenerator) SetConfigWarnings(warnings []string) {
+	g.configWarnings = warnings
+}
+
+// SetChaosDifficulty records the chaos difficulty curve shown in the AAR
+func (g *AARGenerator) SetChaosDifficulty(lines []string) {
+	g.chaosDifficulty = lines
+}
+
+// SetEstimatedDuration sets the pre-run setup estimate compared against the actual time
+func (g *AARGenerator) SetEstimatedDuration(estimate time.Duration) {
+	g.estimatedDuration = estimate
+}
+
 // SetPerformanceTarget sets a configurable performance target
 func (g *AARGenerator) SetPerformanceTarget(key string, target time.Duration) {
 	if g.performanceTargets == nil {
@@ -88,6 +132,9 @@ func (g *AARGenerator) Generate() (*AARSummary, error) {
 		ProjectInfo:   g.buildProjectInfo(),
 		ExecutionInfo: g.buildExecutionInfo(endTime, duration),
 		StepResults:   g.stepResults,
+		Warnings:      g.configWarnings,
+
+		ChaosDifficulty: g.chaosDifficulty,
 	}
 
 	// Generate next steps
@@ -102,6 +149,20 @@ func (g *AARGenerator) Generate() (*AARSummary, error) {
 	return summary, nil
 }
 
+// Commands returns the distinct suggested next-step commands in priority order
+func (s *AARSummary) Commands() []string {
+	seen := make(map[string]bool)
+	var commands []string
+	for _, step := range s.NextSteps {
+		if step.Command == "" || seen[step.Command] {
+			continue
+		}
+		seen[step.Command] = true
+		commands = append(commands, step.Command)
+	}
+	return commands
+}
+
 // buildProjectInfo creates the project information section
 func (g *AARGenerator) buildProjectInfo() ProjectInfo {
 	// Extract template type from config
@@ -126,6 +187,10 @@ func (g *AARGenerator) buildProjectInfo() ProjectInfo {
 		features["cicd"] = g.config.ProductionSetup.CI_CD
 		features["monitoring"] = g.config.ProductionSetup.Monitoring
 		features["analytics"] = g.config.ProductionSetup.Analytics
+		features["azure"] = g.config.ProductionSetup.Azure
+		features["trustbridge"] = g.config.ProductionSetup.TrustBridge
+		features["grpc"] = g.config.ProductionSetup.GRPC
+		features["grid_hdfs"] = g.config.ProductionSetup.GridHDFS
 	}
 
 	return ProjectInfo{
@@ -156,7 +221,7 @@ func (g *AARGenerator) buildExecutionInfo(endTime time.Time, duration time.Durat
 		}
 	}
 
-	return ExecutionInfo{
+	info := ExecutionInfo{
 		StartTime:    g.startTime,
 		EndTime:      endTime,
 		Duration:     duration,
@@ -165,7 +230,34 @@ func (g *AARGenerator) buildExecutionInfo(endTime time.Time, duration time.Durat
 		FailedSteps:  failedSteps,
 		SkippedSteps: skippedSteps,
 		Performance:  g.buildPerformanceMetrics(duration),
+
+		SimulatedDuration: g.simulatedDuration(),
+	}
+
+	if g.estimatedDuration > 0 {
+		info.EstimatedDuration = g.estimatedDuration
+		info.EstimateDelta = g.estimatedDuration - duration
+	}
+
+	return info
+}
+
+// simulatedDuration totals the planned durations of the steps that ran
+func (g *AARGenerator) simulatedDuration() time.Duration {
+	if g.tracker == nil {
+		return 0
+	}
+
+	var total time.Duration
+	for _, result := range g.stepResults {
+		if result.Status == StepStatusSkipped {
+			continue
+		}
+		if step := g.tracker.GetStepByName(result.Name); step != nil {
+			total += step.Duration
+		}
 	}
+	return total
 }
 
 // buildPerformanceMetrics creates performance metrics
@@ -326,10 +418,7 @@ func (g *AARGenerator) isDevOnly() bool {
 	}
 
 	// Check if production features are disabled
-	return !g.config.ProductionSetup.Docker &&
-		   !g.config.ProductionSetup.CI_CD &&
-		   !g.config.ProductionSetup.Monitoring &&
-		   !g.config.ProductionSetup.Analytics
+	return g.config.ProductionSetup.IsDevOnly()
 }
 
 // GetProjectDirectory returns the full path to the created project
@@ -0,0 +1,133 @@
+package aar
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	progresssim "github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+)
+
+// newTestGenerator builds a generator over the default create steps
+func newTestGenerator() *AARGenerator {
+	userConfig := &config.UserConfiguration{
+		ProjectName: "TestApp",
+		Template:    config.TemplateConfig{Type: config.TypeScript},
+	}
+	return NewAARGenerator(progresssim.NewCreateTracker(false), userConfig, time.Now(), "./TestApp")
+}
+
+func TestStepTagsCarriedToStepResults(t *testing.T) {
+	g := newTestGenerator()
+	g.RecordStep("Installing dependencies", StepStatusSuccess, 4*time.Second, "")
+	g.RecordStep("Generating project structure", StepStatusSuccess, time.Second, "")
+
+	summary, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	want := progresssim.NewCreateTracker(false).GetStepByName("Installing dependencies").Tags
+	if len(want) == 0 {
+		t.Fatal("default Installing dependencies step has no tags")
+	}
+	if got := summary.StepResults[0].Tags; !reflect.DeepEqual(got, want) {
+		t.Errorf("StepResults[0].Tags = %v, want %v", got, want)
+	}
+}
+
+func TestEstimateComparisonInAAR(t *testing.T) {
+	g := newTestGenerator()
+	g.SetEstimatedDuration(4*time.Minute + 30*time.Second)
+	g.RecordStep("Installing dependencies", StepStatusSuccess, time.Second, "")
+
+	summary, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	info := summary.ExecutionInfo
+	if info.EstimatedDuration != 4*time.Minute+30*time.Second {
+		t.Errorf("EstimatedDuration = %s, want 4m30s", info.EstimatedDuration)
+	}
+	if want := info.EstimatedDuration - info.Duration; info.EstimateDelta != want {
+		t.Errorf("EstimateDelta = %s, want estimate - actual = %s", info.EstimateDelta, want)
+	}
+
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+	line := lineContaining(t, formatter.Format(summary), "Estimated")
+	if !strings.Contains(line, "Estimated 4m 30s, actual 0m 00s") || !strings.Contains(line, "faster") {
+		t.Errorf("estimate line = %q, want estimated and actual times with the delta", line)
+	}
+}
+
+func TestFormatEstimateComparison(t *testing.T) {
+	tests := []struct {
+		estimate, actual time.Duration
+		want             string
+	}{
+		{4*time.Minute + 30*time.Second, 13 * time.Second, "Estimated 4m 30s, actual 0m 13s (4m 17s faster)"},
+		{time.Minute, 90 * time.Second, "Estimated 1m 00s, actual 1m 30s (0m 30s slower)"},
+		{time.Minute, time.Minute, "Estimated 1m 00s, actual 1m 00s"},
+	}
+
+	formatter := NewStandardFormatter(80)
+	for _, tt := range tests {
+		info := ExecutionInfo{EstimatedDuration: tt.estimate, Duration: tt.actual, EstimateDelta: tt.estimate - tt.actual}
+		if got := formatter.formatEstimateComparison(info); got != tt.want {
+			t.Errorf("formatEstimateComparison(%s, %s) = %q, want %q", tt.estimate, tt.actual, got, tt.want)
+		}
+	}
+}
+
+func TestSimulatedAndWallClockTimeBothReported(t *testing.T) {
+	g := newTestGenerator()
+	g.RecordStep("Installing dependencies", StepStatusSuccess, 0, "")
+	g.RecordStep("Generating project structure", StepStatusSuccess, 0, "")
+	g.RecordStep("Configuring development environment", StepStatusSkipped, 0, "")
+
+	summary, err := g.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	tracker := progresssim.NewCreateTracker(false)
+	want := tracker.GetStepByName("Installing dependencies").Duration +
+		tracker.GetStepByName("Generating project structure").Duration
+	info := summary.ExecutionInfo
+	if info.SimulatedDuration != want {
+		t.Errorf("SimulatedDuration = %s, want the ran steps' planned %s", info.SimulatedDuration, want)
+	}
+	if !info.SimulatedTimeDiffers() {
+		t.Fatalf("SimulatedTimeDiffers() = false for wall clock %s vs simulated %s", info.Duration, info.SimulatedDuration)
+	}
+
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+	line := lineContaining(t, formatter.Format(summary), "Wall clock")
+	if !strings.Contains(line, "simulated") {
+		t.Errorf("time line = %q, want both wall clock and simulated times", line)
+	}
+}
+
+func TestSimulatedTimeHiddenWhenItMatches(t *testing.T) {
+	for _, info := range []ExecutionInfo{
+		{Duration: 90 * time.Second, SimulatedDuration: 90*time.Second + 20*time.Millisecond},
+		{Duration: 90*time.Second + 4*time.Second, SimulatedDuration: 90 * time.Second},
+	} {
+		if info.SimulatedTimeDiffers() {
+			t.Errorf("SimulatedTimeDiffers() = true for wall clock %s vs simulated %s", info.Duration, info.SimulatedDuration)
+		}
+	}
+
+	summary := newTestSummary()
+	summary.ExecutionInfo.SimulatedDuration = summary.ExecutionInfo.Duration
+	formatter := NewStandardFormatter(100)
+	formatter.SetColorEnabled(false)
+	if output := formatter.Format(summary); strings.Contains(output, "Wall clock") {
+		t.Errorf("AAR shows both times although they match:\n%s", output)
+	}
+}
@@ -13,6 +13,8 @@ type AARSummary struct {
 	StepResults    []StepResult     `json:"step_results"`
 	NextSteps      []NextStep       `json:"next_steps"`
 	Troubleshooting *TroubleshootingInfo `json:"troubleshooting,omitempty"`
+	Warnings       []string         `json:"warnings,omitempty"` // Configuration warnings that applied to the run
+	ChaosDifficulty []string        `json:"chaos_difficulty,omitempty"` // Difficulty curve of a chaos run, one line per entry
 }
 
 // ProjectInfo contains information about the created project
@@ -35,8 +37,38 @@ type ExecutionInfo struct {
 	FailedSteps   int               `json:"failed_steps"`
 	SkippedSteps  int               `json:"skipped_steps"`
 	Performance   PerformanceMetrics `json:"performance"`
+
+	// Pre-run estimate (the tracker's planned duration) for fidelity comparison
+	EstimatedDuration time.Duration `json:"estimated_duration,omitempty"`
+	EstimateDelta     time.Duration `json:"estimate_delta,omitempty"` // Estimated minus actual
+
+	// Planned (simulated) time of the recorded steps; Duration is real wall-clock time
+	SimulatedDuration time.Duration `json:"simulated_duration,omitempty"`
+}
+
+// SimulatedTimeDiffers reports whether the simulated step time and the real
+// wall-clock time differ enough to be worth showing both. Scheduling and
+// rendering overhead always add a little wall time, so only a difference of
+// more than simulatedTimeTolerance of the longer time counts.
+func (info ExecutionInfo) SimulatedTimeDiffers() bool {
+	if info.SimulatedDuration <= 0 {
+		return false
+	}
+	diff := info.SimulatedDuration - info.Duration
+	if diff < 0 {
+		diff = -diff
+	}
+	longer := info.SimulatedDuration
+	if info.Duration > longer {
+		longer = info.Duration
+	}
+	return float64(diff) > simulatedTimeTolerance*float64(longer)
 }
 
+// simulatedTimeTolerance is the relative difference between simulated and
+// wall-clock time below which only the wall clock is shown
+const simulatedTimeTolerance = 0.1
+
 // PerformanceMetrics contains performance and timing data
 type PerformanceMetrics struct {
 	AverageStepTime   time.Duration `json:"average_step_time"`
@@ -57,6 +89,7 @@ type StepResult struct {
 	ErrorMessage string        `json:"error_message,omitempty"`
 	Details      string        `json:"details,omitempty"`
 	SubSteps     []SubStepResult `json:"sub_steps,omitempty"`
+	Tags         []string      `json:"tags,omitempty"`
 }
 
 // SubStepResult contains detailed sub-step information for verbose/debug modes
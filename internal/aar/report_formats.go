@@ -0,0 +1,198 @@
+package aar
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ReportFormat is a machine-readable AAR file format
+type ReportFormat int
+
+const (
+	ReportJSON ReportFormat = iota
+	ReportMarkdown
+	ReportCSV
+)
+
+// String returns the --report-format name of the format
+func (f ReportFormat) String() string {
+	switch f {
+	case ReportJSON:
+		return "json"
+	case ReportMarkdown:
+		return "markdown"
+	case ReportCSV:
+		return "csv"
+	default:
+		return "unknown"
+	}
+}
+
+// Extension returns the file extension used for the format
+func (f ReportFormat) Extension() string {
+	switch f {
+	case ReportMarkdown:
+		return "md"
+	default:
+		return f.String()
+	}
+}
+
+// Formatter returns the OutputFormatter that renders the format
+func (f ReportFormat) Formatter() OutputFormatter {
+	switch f {
+	case ReportMarkdown:
+		return &MarkdownFormatter{}
+	case ReportCSV:
+		return &CSVFormatter{}
+	default:
+		return &JSONFormatter{}
+	}
+}
+
+// ParseReportFormats parses a comma-separated --report-format value,
+// ignoring duplicates and rejecting unknown names
+func ParseReportFormats(s string) ([]ReportFormat, error) {
+	var formats []ReportFormat
+	seen := make(map[ReportFormat]bool)
+	for _, name := range strings.Split(s, ",") {
+		var format ReportFormat
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "json":
+			format = ReportJSON
+		case "markdown", "md":
+			format = ReportMarkdown
+		case "csv":
+			format = ReportCSV
+		default:
+			return nil, fmt.Errorf("unknown report format: %q (expected json, markdown or csv)", name)
+		}
+		if !seen[format] {
+			seen[format] = true
+			formats = append(formats, format)
+		}
+	}
+	return formats, nil
+}
+
+// WriteReports writes one aar.<ext> file per format into dir and returns the paths written
+func WriteReports(summary *AARSummary, dir string, formats []ReportFormat) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	var paths []string
+	for _, format := range formats {
+		path := filepath.Join(dir, "aar."+format.Extension())
+		if err := os.WriteFile(path, []byte(format.Formatter().Format(summary)), 0644); err != nil {
+			return paths, fmt.Errorf("failed to write %s report: %w", format, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// JSONFormatter renders the full summary as indented JSON
+type JSONFormatter struct{}
+
+// Format implements OutputFormatter
+func (f *JSONFormatter) Format(summary *AARSummary) string {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("{\"error\": %q}\n", err.Error())
+	}
+	return string(data) + "\n"
+}
+
+// MarkdownFormatter renders the summary as a Markdown document
+type MarkdownFormatter struct{}
+
+// Format implements OutputFormatter
+func (f *MarkdownFormatter) Format(summary *AARSummary) string {
+	var output strings.Builder
+
+	outcome := "Success"
+	if summary.ExecutionInfo.FailedSteps > 0 {
+		outcome = "Completed with errors"
+	}
+
+	output.WriteString(fmt.Sprintf("# After Action Summary: %s\n\n", summary.ProjectInfo.Name))
+	output.WriteString(fmt.Sprintf("- **Outcome:** %s\n", outcome))
+	output.WriteString(fmt.Sprintf("- **Template:** %s\n", summary.ProjectInfo.Template))
+	output.WriteString(fmt.Sprintf("- **Directory:** `%s`\n", summary.ProjectInfo.Directory))
+	output.WriteString(fmt.Sprintf("- **Steps:** %d/%d completed\n", summary.ExecutionInfo.SuccessSteps, summary.ExecutionInfo.TotalSteps))
+	output.WriteString(fmt.Sprintf("- **Duration:** %s\n", summary.ExecutionInfo.Duration.Round(time.Millisecond)))
+	if summary.ExecutionInfo.SimulatedTimeDiffers() {
+		output.WriteString(fmt.Sprintf("- **Simulated duration:** %s\n", summary.ExecutionInfo.SimulatedDuration.Round(time.Millisecond)))
+	}
+
+	if len(summary.Warnings) > 0 {
+		output.WriteString("\n## Configuration warnings\n\n")
+		for _, warning := range summary.Warnings {
+			output.WriteString(fmt.Sprintf("- %s\n", warning))
+		}
+	}
+
+	if len(summary.ChaosDifficulty) > 0 {
+		output.WriteString("\n## Chaos difficulty\n\n")
+		for _, line := range summary.ChaosDifficulty {
+			output.WriteString(fmt.Sprintf("- %s\n", line))
+		}
+	}
+
+	if len(summary.StepResults) > 0 {
+		output.WriteString("\n## Steps\n\n")
+		output.WriteString("| Step | Status | Duration | Error |\n")
+		output.WriteString("|------|--------|----------|-------|\n")
+		for _, step := range summary.StepResults {
+			output.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+				markdownCell(step.Name), step.Status, step.Duration.Round(time.Millisecond), markdownCell(step.ErrorMessage)))
+		}
+	}
+
+	if len(summary.NextSteps) > 0 {
+		output.WriteString("\n## Next steps\n\n")
+		for _, step := range summary.NextSteps {
+			if step.Command != "" {
+				output.WriteString(fmt.Sprintf("- **%s** — %s: `%s`\n", step.Action, step.Description, step.Command))
+			} else {
+				output.WriteString(fmt.Sprintf("- **%s** — %s\n", step.Action, step.Description))
+			}
+		}
+	}
+
+	return output.String()
+}
+
+// markdownCell escapes table separators and flattens newlines for a table cell
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// CSVFormatter renders one row per step for spreadsheets and dashboards
+type CSVFormatter struct{}
+
+// Format implements OutputFormatter
+func (f *CSVFormatter) Format(summary *AARSummary) string {
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+
+	_ = writer.Write([]string{"step", "status", "duration_ms", "error"})
+	for _, step := range summary.StepResults {
+		_ = writer.Write([]string{
+			step.Name,
+			step.Status.String(),
+			fmt.Sprintf("%d", step.Duration.Milliseconds()),
+			step.ErrorMessage,
+		})
+	}
+	writer.Flush()
+
+	return output.String()
+}
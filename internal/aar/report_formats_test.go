@@ -0,0 +1,78 @@
+package aar
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseReportFormats(t *testing.T) {
+	formats, err := ParseReportFormats("json, Markdown,csv,md")
+	if err != nil {
+		t.Fatalf("ParseReportFormats() error = %v", err)
+	}
+	if want := []ReportFormat{ReportJSON, ReportMarkdown, ReportCSV}; !reflect.DeepEqual(formats, want) {
+		t.Errorf("ParseReportFormats() = %v, want %v", formats, want)
+	}
+
+	for _, bad := range []string{"json,xml", "", "json,,csv"} {
+		if _, err := ParseReportFormats(bad); err == nil {
+			t.Errorf("ParseReportFormats(%q) error = nil, want an unknown format error", bad)
+		}
+	}
+}
+
+func TestWriteReportsProducesEveryRequestedFile(t *testing.T) {
+	summary := newTestSummary()
+	summary.StepResults = []StepResult{{Name: "Installing dependencies", Status: StepStatusSuccess}}
+
+	formats, err := ParseReportFormats("json,markdown,csv")
+	if err != nil {
+		t.Fatalf("ParseReportFormats() error = %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "reports")
+	paths, err := WriteReports(summary, dir, formats)
+	if err != nil {
+		t.Fatalf("WriteReports() error = %v", err)
+	}
+
+	var names []string
+	for _, path := range paths {
+		names = append(names, filepath.Base(path))
+	}
+	if want := []string{"aar.json", "aar.md", "aar.csv"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("WriteReports() wrote %q, want %q", names, want)
+	}
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("reading %s: %v", name, err)
+		}
+		return string(data)
+	}
+
+	var decoded AARSummary
+	if err := json.Unmarshal([]byte(read("aar.json")), &decoded); err != nil {
+		t.Errorf("aar.json is not valid JSON: %v", err)
+	} else if decoded.ProjectInfo.Name != "TestApp" {
+		t.Errorf("aar.json project name = %q, want TestApp", decoded.ProjectInfo.Name)
+	}
+
+	if md := read("aar.md"); !strings.HasPrefix(md, "#") || !strings.Contains(md, "Installing dependencies") {
+		t.Errorf("aar.md is not a Markdown report of the run:\n%s", md)
+	}
+
+	records, err := csv.NewReader(strings.NewReader(read("aar.csv"))).ReadAll()
+	if err != nil {
+		t.Fatalf("aar.csv is not valid CSV: %v", err)
+	}
+	if len(records) < 2 {
+		t.Errorf("aar.csv has %d rows, want a header and the step", len(records))
+	}
+}
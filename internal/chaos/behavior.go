@@ -175,6 +175,11 @@ func (bt *BehaviorTracker) StartSession() string {
 	bt.mutex.Lock()
 	defer bt.mutex.Unlock()
 
+	return bt.startSessionLocked()
+}
+
+// startSessionLocked starts a new session; the caller must hold bt.mutex
+func (bt *BehaviorTracker) startSessionLocked() string {
 	sessionID := generateSessionID()
 	session := &Session{
 		ID:        sessionID,
@@ -209,7 +214,7 @@ func (bt *BehaviorTracker) RecordAction(action UserAction) error {
 
 	if bt.currentSession == nil {
 		// Auto-start session if none exists
-		bt.StartSession()
+		bt.startSessionLocked()
 	}
 
 	bt.currentSession.Actions = append(bt.currentSession.Actions, action)
@@ -62,8 +62,14 @@ func (a AggressivenessLevel) FailureRate() float64 {
 	}
 }
 
-// ParseAggressivenessLevel parses a string into an AggressivenessLevel
+// ParseAggressivenessLevel parses a built-in level name into an
+// AggressivenessLevel; use ChaosConfig.ParseLevel to also accept aliases
 func ParseAggressivenessLevel(s string) (AggressivenessLevel, error) {
+	return parseBuiltinLevel(s)
+}
+
+// parseBuiltinLevel parses one of the built-in level names
+func parseBuiltinLevel(s string) (AggressivenessLevel, error) {
 	switch strings.ToLower(strings.TrimSpace(s)) {
 	case "off", "false", "0":
 		return Off, nil
@@ -110,10 +116,29 @@ type ChaosConfig struct {
 	TelemetryEnabled     bool `json:"telemetry_enabled" yaml:"telemetry_enabled"`
 	AnonymousReporting   bool `json:"anonymous_reporting" yaml:"anonymous_reporting"`
 	MetricsRetentionDays int  `json:"metrics_retention_days" yaml:"metrics_retention_days"`
+	TelemetryPath        string `json:"telemetry_path,omitempty" yaml:"telemetry_path,omitempty"`
 
 	// Advanced features (Phase 3)
 	FailureChaining bool `json:"failure_chaining" yaml:"failure_chaining"`
 	CascadePrevent  bool `json:"cascade_prevent" yaml:"cascade_prevent"`
+
+	// Recovery tuning: success probability per assistance level and skill level
+	RecoverySuccessRates map[AssistanceLevel]map[SkillLevel]float64 `json:"recovery_success_rates,omitempty" yaml:"recovery_success_rates,omitempty"`
+
+	// Preview mode: report would-be injections without failing anything
+	DryRun bool `json:"dry_run" yaml:"dry_run"`
+
+	// Stop injecting once this much time has elapsed (0 = never)
+	OffAfter time.Duration `json:"off_after,omitempty" yaml:"off_after,omitempty"`
+
+	// Scripted demos: recovery fails until this attempt, then succeeds (0 = random)
+	ForcedRecoveryAttempt int `json:"forced_recovery_attempt,omitempty" yaml:"forced_recovery_attempt,omitempty"`
+
+	// Org-specific level names, e.g. {"nuclear": "apocalyptic"}
+	LevelAliases map[string]string `json:"level_aliases,omitempty" yaml:"level_aliases,omitempty"`
+
+	// Confine injection to these step names (empty = every step)
+	Steps []string `json:"steps,omitempty" yaml:"steps,omitempty"`
 }
 
 // NewDefaultConfig creates a default chaos configuration
@@ -151,7 +176,78 @@ func NewDefaultConfig() *ChaosConfig {
 		// Advanced features disabled by default
 		FailureChaining: false,
 		CascadePrevent:  true,
+
+		// Recovery defaults
+		RecoverySuccessRates: DefaultRecoverySuccessRates(),
+	}
+}
+
+// DefaultRecoverySuccessRates returns the built-in recovery success model
+func DefaultRecoverySuccessRates() map[AssistanceLevel]map[SkillLevel]float64 {
+	return map[AssistanceLevel]map[SkillLevel]float64{
+		MinimalAssistance: {
+			Novice:       0.2,
+			Intermediate: 0.4,
+			Advanced:     0.6,
+			Expert:       0.8,
+		},
+		HintProvided: {
+			Novice:       0.6,
+			Intermediate: 0.75,
+			Advanced:     0.85,
+			Expert:       0.95,
+		},
+	}
+}
+
+// ParseLevel parses a built-in level name or one of the config's
+// LevelAliases into an AggressivenessLevel
+func (c *ChaosConfig) ParseLevel(s string) (AggressivenessLevel, error) {
+	level, err := parseBuiltinLevel(s)
+	if err == nil {
+		return level, nil
+	}
+
+	name := strings.ToLower(strings.TrimSpace(s))
+	for alias, target := range c.LevelAliases {
+		if strings.ToLower(strings.TrimSpace(alias)) == name {
+			return parseBuiltinLevel(target)
+		}
+	}
+	return Off, err
+}
+
+// validateLevelAliases checks that each alias is new and maps to a built-in level name
+func (c *ChaosConfig) validateLevelAliases() error {
+	for alias, target := range c.LevelAliases {
+		name := strings.ToLower(strings.TrimSpace(alias))
+		if name == "" {
+			return errors.New("level alias must not be empty")
+		}
+		if _, err := parseBuiltinLevel(name); err == nil {
+			return fmt.Errorf("level alias %q shadows a built-in level", alias)
+		}
+		if _, err := parseBuiltinLevel(target); err != nil {
+			return fmt.Errorf("level alias %q: %w", alias, err)
+		}
 	}
+	return nil
+}
+
+// GetRecoverySuccessRate returns the recovery success rate for an assistance and skill level,
+// falling back to the built-in model when the configuration doesn't override it
+func (c *ChaosConfig) GetRecoverySuccessRate(assistance AssistanceLevel, skill SkillLevel) (float64, bool) {
+	if rates, exists := c.RecoverySuccessRates[assistance]; exists {
+		if rate, exists := rates[skill]; exists {
+			return clamp(rate, 0.0, 1.0), true
+		}
+	}
+
+	if rate, exists := DefaultRecoverySuccessRates()[assistance][skill]; exists {
+		return rate, true
+	}
+
+	return 0, false
 }
 
 // Validate validates the chaos configuration
@@ -181,6 +277,16 @@ func (c *ChaosConfig) Validate() error {
 		return errors.New("metrics_retention_days must be between 1 and 90")
 	}
 
+	// Forced recovery validation
+	if c.ForcedRecoveryAttempt < 0 {
+		return errors.New("forced_recovery_attempt must be >= 0")
+	}
+
+	// Level alias validation
+	if err := c.validateLevelAliases(); err != nil {
+		return fmt.Errorf("invalid level_aliases: %w", err)
+	}
+
 	return nil
 }
 
@@ -194,11 +300,14 @@ func LoadChaosConfig(level string, seed int64, configPath string) (*ChaosConfig,
 		if err := loadConfigFromFile(config, configPath); err != nil {
 			return nil, fmt.Errorf("failed to load config from file %s: %w", configPath, err)
 		}
+		if err := config.validateLevelAliases(); err != nil {
+			return nil, fmt.Errorf("invalid level_aliases in %s: %w", configPath, err)
+		}
 	}
 
 	// Override with command line parameters
 	if level != "" {
-		aggressiveness, err := ParseAggressivenessLevel(level)
+		aggressiveness, err := config.ParseLevel(level)
 		if err != nil {
 			return nil, fmt.Errorf("invalid aggressiveness level: %w", err)
 		}
@@ -219,6 +328,12 @@ func LoadChaosConfig(level string, seed int64, configPath string) (*ChaosConfig,
 	return config, nil
 }
 
+// ParseConfigData reports whether data parses as a chaos configuration file
+func ParseConfigData(data []byte) error {
+	var config ChaosConfig
+	return json.Unmarshal(data, &config)
+}
+
 // loadConfigFromFile loads configuration from a JSON file
 func loadConfigFromFile(config *ChaosConfig, path string) error {
 	// Check if file exists
@@ -237,9 +352,33 @@ func loadConfigFromFile(config *ChaosConfig, path string) error {
 		return fmt.Errorf("failed to parse configuration JSON: %w", err)
 	}
 
+	config.expandEnv()
+
 	return nil
 }
 
+// expandEnv expands $VAR and ${VAR} references in path-like fields, then
+// normalizes prohibited paths. Prohibited paths that expand to nothing are
+// dropped so an unset variable can't become a prefix matching every path.
+func (c *ChaosConfig) expandEnv() {
+	prohibited := make([]string, 0, len(c.ProhibitedPaths))
+	for _, path := range c.ProhibitedPaths {
+		expanded := os.ExpandEnv(path)
+		if expanded == "" {
+			continue
+		}
+		prohibited = append(prohibited, filepath.Clean(expanded))
+	}
+	c.ProhibitedPaths = prohibited
+
+	// Keep empty operations: dropping them could empty the list, which allows everything
+	for i, operation := range c.AllowedOperations {
+		c.AllowedOperations[i] = os.ExpandEnv(operation)
+	}
+
+	c.TelemetryPath = os.ExpandEnv(c.TelemetryPath)
+}
+
 // SaveConfigToFile saves configuration to a JSON file
 func (c *ChaosConfig) SaveConfigToFile(path string) error {
 	// Create directory if it doesn't exist
@@ -279,6 +418,33 @@ func (c *ChaosConfig) IsOperationAllowed(operation string) bool {
 	return false
 }
 
+// IsStepEnabled checks if chaos may be injected into the named step
+func (c *ChaosConfig) IsStepEnabled(step string) bool {
+	// If no steps specified, every step is eligible
+	if len(c.Steps) == 0 {
+		return true
+	}
+
+	for _, enabled := range c.Steps {
+		if strings.EqualFold(step, enabled) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseStepList splits a comma-separated list of step names, dropping blanks
+func ParseStepList(value string) []string {
+	var steps []string
+	for _, step := range strings.Split(value, ",") {
+		if step = strings.TrimSpace(step); step != "" {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
 // IsPathProhibited checks if a path is prohibited for chaos operations
 func (c *ChaosConfig) IsPathProhibited(path string) bool {
 	for _, prohibited := range c.ProhibitedPaths {
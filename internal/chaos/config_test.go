@@ -0,0 +1,147 @@
+package chaos
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeChaosConfig writes a chaos configuration file and returns its path
+func writeChaosConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "chaos.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("writing chaos config: %v", err)
+	}
+	return path
+}
+
+func TestLoadChaosConfigExpandsEnvInProhibitedPaths(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ENGX_TEST_UNSET", "")
+
+	path := writeChaosConfig(t, `{"prohibited_paths": ["$HOME/secrets", "${HOME}/keys/", "${ENGX_TEST_UNSET}"]}`)
+	config, err := LoadChaosConfig("", 0, path)
+	if err != nil {
+		t.Fatalf("LoadChaosConfig() error = %v", err)
+	}
+
+	want := []string{filepath.Join(home, "secrets"), filepath.Join(home, "keys")}
+	if len(config.ProhibitedPaths) != len(want) {
+		t.Fatalf("ProhibitedPaths = %v, want %v (unset variables dropped)", config.ProhibitedPaths, want)
+	}
+	for i, path := range want {
+		if config.ProhibitedPaths[i] != path {
+			t.Errorf("ProhibitedPaths[%d] = %q, want %q", i, config.ProhibitedPaths[i], path)
+		}
+	}
+
+	if !config.IsPathProhibited(filepath.Join(home, "secrets", "id_rsa")) {
+		t.Error("a file under the expanded $HOME/secrets is not prohibited")
+	}
+	if config.IsPathProhibited(filepath.Join(home, "projects", "app")) {
+		t.Error("an unrelated path under $HOME is prohibited")
+	}
+}
+
+func TestLevelAliasesParseToBuiltinLevels(t *testing.T) {
+	path := writeChaosConfig(t, `{"level_aliases": {"Nuclear": "apocalyptic", "recon": "scout"}}`)
+
+	config, err := LoadChaosConfig("nuclear", 0, path)
+	if err != nil {
+		t.Fatalf("LoadChaosConfig(nuclear) error = %v", err)
+	}
+	if config.AggressivenessLevel != Apocalyptic || !config.Enabled {
+		t.Errorf("--chaos=nuclear loaded level %s (enabled %t), want apocalyptic", config.AggressivenessLevel, config.Enabled)
+	}
+
+	tests := []struct {
+		input string
+		want  AggressivenessLevel
+	}{
+		{"NUCLEAR", Apocalyptic},
+		{" recon ", Scout},
+		{"invasive", Invasive},
+		{"off", Off},
+	}
+	for _, tt := range tests {
+		got, err := config.ParseLevel(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseLevel(%q) = %s, %v; want %s", tt.input, got, err, tt.want)
+		}
+	}
+}
+
+func TestLevelAliasesStayWithTheirConfig(t *testing.T) {
+	path := writeChaosConfig(t, `{"level_aliases": {"nuclear": "apocalyptic"}}`)
+	if _, err := LoadChaosConfig("nuclear", 0, path); err != nil {
+		t.Fatalf("LoadChaosConfig(nuclear) error = %v", err)
+	}
+
+	// Loading one config must not teach every other config its aliases
+	if _, err := LoadChaosConfig("nuclear", 0, ""); err == nil {
+		t.Error("LoadChaosConfig(nuclear) without the alias config succeeded")
+	}
+	if _, err := NewDefaultConfig().ParseLevel("nuclear"); err == nil {
+		t.Error("a default config parsed an alias it doesn't define")
+	}
+	if _, err := ParseAggressivenessLevel("nuclear"); err == nil {
+		t.Error("ParseAggressivenessLevel accepted an alias")
+	}
+}
+
+func TestInvalidLevelAliasesAreRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		aliases string
+	}{
+		{"shadows a built-in level", `{"scout": "apocalyptic"}`},
+		{"unknown target", `{"nuclear": "armageddon"}`},
+		{"empty alias", `{" ": "scout"}`},
+	}
+
+	for _, tt := range tests {
+		path := writeChaosConfig(t, `{"level_aliases": `+tt.aliases+`}`)
+		if _, err := LoadChaosConfig("", 0, path); err == nil {
+			t.Errorf("%s: LoadChaosConfig() accepted level_aliases %s", tt.name, tt.aliases)
+		}
+
+		config := NewDefaultConfig()
+		if err := json.Unmarshal([]byte(tt.aliases), &config.LevelAliases); err != nil {
+			t.Fatalf("%s: decoding aliases: %v", tt.name, err)
+		}
+		if err := config.Validate(); err == nil {
+			t.Errorf("%s: Validate() accepted level_aliases %s", tt.name, tt.aliases)
+		}
+	}
+}
+
+func TestParseStepList(t *testing.T) {
+	got := ParseStepList(" Installing dependencies, ,Setting up environment,")
+	want := []string{"Installing dependencies", "Setting up environment"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseStepList() = %q, want %q", got, want)
+	}
+	if got := ParseStepList(" , "); len(got) != 0 {
+		t.Errorf("ParseStepList() of blanks = %q, want none", got)
+	}
+}
+
+func TestIsStepEnabled(t *testing.T) {
+	config := NewDefaultConfig()
+	if !config.IsStepEnabled("Finalizing Setup") {
+		t.Error("IsStepEnabled() = false with no step list, want every step eligible")
+	}
+
+	config.Steps = []string{"Installing dependencies"}
+	if !config.IsStepEnabled("installing DEPENDENCIES") {
+		t.Error("IsStepEnabled() is case-sensitive, want listed steps matched case-insensitively")
+	}
+	if config.IsStepEnabled("Finalizing Setup") {
+		t.Error("IsStepEnabled() = true for an unlisted step")
+	}
+}
@@ -0,0 +1,57 @@
+package chaos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// difficultySparks holds one sparkline block per aggressiveness level, Off to Apocalyptic
+var difficultySparks = []rune("▁▂▃▅▆█")
+
+// difficultySpark returns the sparkline block for a level
+func difficultySpark(level AggressivenessLevel) rune {
+	if level < 0 {
+		level = 0
+	}
+	if int(level) >= len(difficultySparks) {
+		level = AggressivenessLevel(len(difficultySparks) - 1)
+	}
+	return difficultySparks[level]
+}
+
+// DifficultySparkline renders the levels visited during a run, starting from
+// the level before the first adaptation
+func DifficultySparkline(history []AdaptationEvent) string {
+	if len(history) == 0 {
+		return ""
+	}
+
+	var spark strings.Builder
+	spark.WriteRune(difficultySpark(history[0].PreviousLevel))
+	for _, event := range history {
+		spark.WriteRune(difficultySpark(event.NewLevel))
+	}
+	return spark.String()
+}
+
+// FormatDifficultyCurve renders the adaptation history as a sparkline line
+// followed by one timeline line per transition, timed relative to start
+func FormatDifficultyCurve(history []AdaptationEvent, start time.Time) []string {
+	if len(history) == 0 {
+		return nil
+	}
+
+	lines := []string{fmt.Sprintf("%s  %d adaptation(s), started at %s, ended at %s", DifficultySparkline(history),
+		len(history), history[0].PreviousLevel.String(), history[len(history)-1].NewLevel.String())}
+
+	for _, event := range history {
+		offset := event.Timestamp.Sub(start).Round(100 * time.Millisecond)
+		if offset < 0 {
+			offset = 0
+		}
+		lines = append(lines, fmt.Sprintf("+%s %s → %s: %s",
+			offset, event.PreviousLevel.String(), event.NewLevel.String(), event.Reason))
+	}
+	return lines
+}
@@ -0,0 +1,55 @@
+package chaos
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatDifficultyCurveListsEventsInOrder(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	history := []AdaptationEvent{
+		{Timestamp: start.Add(2 * time.Second), PreviousLevel: Default, NewLevel: Scout, Reason: "user recovered quickly"},
+		{Timestamp: start.Add(5 * time.Second), PreviousLevel: Scout, NewLevel: Aggressive, Reason: "high success rate"},
+		{Timestamp: start.Add(9 * time.Second), PreviousLevel: Aggressive, NewLevel: Default, Reason: "user struggling"},
+	}
+
+	lines := FormatDifficultyCurve(history, start)
+	if len(lines) != len(history)+1 {
+		t.Fatalf("FormatDifficultyCurve() returned %d lines, want a summary plus %d events:\n%s",
+			len(lines), len(history), strings.Join(lines, "\n"))
+	}
+
+	if want := "▂▃▅▂  3 adaptation(s), started at default, ended at default"; lines[0] != want {
+		t.Errorf("summary line = %q, want %q", lines[0], want)
+	}
+
+	want := []string{
+		"+2s default → scout: user recovered quickly",
+		"+5s scout → aggressive: high success rate",
+		"+9s aggressive → default: user struggling",
+	}
+	for i, line := range lines[1:] {
+		if line != want[i] {
+			t.Errorf("event line %d = %q, want %q", i, line, want[i])
+		}
+	}
+}
+
+func TestFormatDifficultyCurveEmptyHistory(t *testing.T) {
+	if lines := FormatDifficultyCurve(nil, time.Now()); lines != nil {
+		t.Errorf("FormatDifficultyCurve(nil) = %q, want nil", lines)
+	}
+	if spark := DifficultySparkline(nil); spark != "" {
+		t.Errorf("DifficultySparkline(nil) = %q, want empty", spark)
+	}
+}
+
+func TestDifficultySparkClampsOutOfRangeLevels(t *testing.T) {
+	if got := difficultySpark(AggressivenessLevel(-1)); got != '▁' {
+		t.Errorf("difficultySpark(-1) = %q, want the Off block", got)
+	}
+	if got := difficultySpark(AggressivenessLevel(99)); got != '█' {
+		t.Errorf("difficultySpark(99) = %q, want the Apocalyptic block", got)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -19,7 +22,9 @@ type ChaosInjector interface {
 
 	// Injection decisions
 	ShouldInject(operation string) bool
+	ShouldInjectTraced(operation string) (bool, string)
 	SelectScenario(operation string) *ChaosScenario
+	RegisterOperationTags(operation string, tags []string)
 	CalculateEnhancedErrorRate(operation string, baseRate float64) float64
 
 	// Failure execution
@@ -30,6 +35,7 @@ type ChaosInjector interface {
 	RecordUserAction(action UserAction) error
 	AnalyzeBehaviorPattern() *BehaviorPattern
 	AdjustDifficulty(pattern *BehaviorPattern) AggressivenessLevel
+	SetAggressivenessLevel(level AggressivenessLevel)
 
 	// Safety and monitoring
 	ValidateSafetyBoundaries() error
@@ -48,6 +54,20 @@ type SafeChaosInjector struct {
 	metrics       *InjectionMetrics
 	mutex         sync.RWMutex
 	startTime     time.Time
+	now           func() time.Time // Injectable clock; defaults to time.Now
+
+	// Failure chaining state
+	chainedScenarios []chainedFailure // Follow-on scenarios queued by chainable failures
+	activeChain      *chainedFailure  // Chained scenario handed out by SelectScenario, awaiting injection
+
+	// Step categorization used for scenario targeting
+	operationTags map[string][]string
+}
+
+// chainedFailure is a follow-on scenario queued by the scenario that triggered it
+type chainedFailure struct {
+	scenario string
+	source   string
 }
 
 // ErrorScenario represents a basic error scenario (placeholder for future error system)
@@ -64,6 +84,8 @@ type ChaosScenario struct {
 	TriggerProbability    float64                    `json:"trigger_probability"`
 	UserSkillModifier     map[SkillLevel]float64     `json:"user_skill_modifier"`
 	ChainableFailures     []string                   `json:"chainable_failures"`
+	Tags                  []string                   `json:"tags"`
+	MessageTemplate       string                     `json:"message_template,omitempty"` // Supports {{operation}}, {{attempt}}, {{type}}, {{message}}
 
 	// Educational features
 	LearningObjectives    []string                   `json:"learning_objectives"`
@@ -120,6 +142,7 @@ type InjectionEvent struct {
 	Operation   string         `json:"operation"`
 	Scenario    string         `json:"scenario"`
 	Success     bool           `json:"success"`
+	ChainedFrom string         `json:"chained_from,omitempty"`
 	UserResponse *UserResponse `json:"user_response,omitempty"`
 	Duration    time.Duration  `json:"duration"`
 }
@@ -170,6 +193,8 @@ func NewSafeChaosInjector(config *ChaosConfig) (*SafeChaosInjector, error) {
 		random:        rng,
 		metrics:       &InjectionMetrics{},
 		startTime:     time.Now(),
+		now:           time.Now,
+		operationTags: make(map[string][]string),
 	}
 
 	return injector, nil
@@ -196,7 +221,35 @@ func (injector *SafeChaosInjector) LoadConfig(config *ChaosConfig) error {
 func (injector *SafeChaosInjector) IsEnabled() bool {
 	injector.mutex.RLock()
 	defer injector.mutex.RUnlock()
-	return injector.config.Enabled
+	return injector.config.Enabled && !injector.pastOffAfter()
+}
+
+// SetClock replaces the injector's time source and restarts its elapsed timer on it
+func (injector *SafeChaosInjector) SetClock(now func() time.Time) {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	if now == nil {
+		now = time.Now
+	}
+	injector.now = now
+	injector.startTime = now()
+}
+
+// MarkStart restarts the elapsed timer, e.g. when execution begins after prompts
+func (injector *SafeChaosInjector) MarkStart() {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	injector.startTime = injector.now()
+}
+
+// pastOffAfter reports whether the OffAfter window has elapsed (caller holds the lock)
+func (injector *SafeChaosInjector) pastOffAfter() bool {
+	if injector.config.OffAfter <= 0 {
+		return false
+	}
+	return injector.now().Sub(injector.startTime) >= injector.config.OffAfter
 }
 
 // GetAggressivenessLevel returns the current aggressiveness level
@@ -212,29 +265,53 @@ func (injector *SafeChaosInjector) GetAggressivenessLevel() AggressivenessLevel
 	return injector.config.AggressivenessLevel
 }
 
+// SetAggressivenessLevel changes the aggressiveness level mid-run, as adaptive
+// difficulty does when the learner's behavior calls for it
+func (injector *SafeChaosInjector) SetAggressivenessLevel(level AggressivenessLevel) {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+	injector.config.AggressivenessLevel = level
+}
+
 // ShouldInject determines if chaos should be injected for the given operation
 func (injector *SafeChaosInjector) ShouldInject(operation string) bool {
+	inject, _ := injector.ShouldInjectTraced(operation)
+	return inject
+}
+
+// ShouldInjectTraced determines if chaos should be injected and explains why
+func (injector *SafeChaosInjector) ShouldInjectTraced(operation string) (bool, string) {
 	injector.mutex.RLock()
 	defer injector.mutex.RUnlock()
 
 	// Quick exit if disabled
 	if !injector.config.Enabled {
-		return false
+		return false, "chaos disabled"
+	}
+
+	// Calm down once the configured chaos window has passed
+	if injector.pastOffAfter() {
+		return false, fmt.Sprintf("chaos switched off after %s", injector.config.OffAfter)
 	}
 
 	// Safety check
 	if err := injector.safetyMonitor.IsOperationSafe(operation); err != nil {
-		return false
+		return false, fmt.Sprintf("safety check failed: %v", err)
 	}
 
 	// Check operation is allowed
 	if !injector.config.IsOperationAllowed(operation) {
-		return false
+		return false, "operation not in allowed list"
+	}
+
+	// Pending chained failures force the next applicable operation to fail
+	if scenario := injector.peekChainedScenario(operation); scenario != nil {
+		return true, fmt.Sprintf("chained failure pending (%s)", scenario.ErrorScenario.Type)
 	}
 
 	// TESTING: Check for guaranteed chaos injection via environment variable
 	if os.Getenv("CHAOS_MARINE_FORCE_INJECTION") == "true" {
-		return true
+		return true, "forced by CHAOS_MARINE_FORCE_INJECTION"
 	}
 
 	// Get base failure rate
@@ -247,7 +324,11 @@ func (injector *SafeChaosInjector) ShouldInject(operation string) bool {
 	}
 
 	// Make injection decision based on probability
-	return injector.random.Float64() < baseRate
+	roll := injector.random.Float64()
+	if roll < baseRate {
+		return true, fmt.Sprintf("roll %.4f below failure rate %.4f", roll, baseRate)
+	}
+	return false, fmt.Sprintf("roll %.4f above failure rate %.4f", roll, baseRate)
 }
 
 // applyAdaptiveDifficulty adjusts the base failure rate based on user behavior
@@ -300,8 +381,13 @@ func clamp(value, min, max float64) float64 {
 
 // SelectScenario selects an appropriate chaos scenario for the operation
 func (injector *SafeChaosInjector) SelectScenario(operation string) *ChaosScenario {
-	injector.mutex.RLock()
-	defer injector.mutex.RUnlock()
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	// Chained failures take priority over regular selection
+	if scenario := injector.nextChainedScenario(operation); scenario != nil {
+		return scenario
+	}
 
 	// Get scenarios applicable to this operation
 	candidates := injector.getApplicableScenarios(operation)
@@ -323,11 +409,124 @@ func (injector *SafeChaosInjector) SelectScenario(operation string) *ChaosScenar
 	return injector.selectWeightedScenario(candidates)
 }
 
+// isChainingActive reports whether chained failures may be forced.
+// Cascade prevention wins over chaining when both are enabled.
+func (injector *SafeChaosInjector) isChainingActive() bool {
+	return injector.config.FailureChaining && !injector.config.CascadePrevent
+}
+
+// hasPendingChain checks if a chained failure is waiting to be injected
+func (injector *SafeChaosInjector) hasPendingChain() bool {
+	return injector.isChainingActive() && len(injector.chainedScenarios) > 0
+}
+
+// peekChainedScenario returns the first queued chained scenario that applies
+// to the operation. Queued scenarios for other operations stay queued and
+// don't block the ones behind them.
+func (injector *SafeChaosInjector) peekChainedScenario(operation string) *ChaosScenario {
+	if index := injector.findChainedScenario(operation); index >= 0 {
+		return injector.scenarios[injector.chainedScenarios[index].scenario]
+	}
+	return nil
+}
+
+// findChainedScenario returns the queue index of the first chained scenario
+// that applies to the operation, or -1 if none does
+func (injector *SafeChaosInjector) findChainedScenario(operation string) int {
+	if !injector.hasPendingChain() {
+		return -1
+	}
+
+	for i, next := range injector.chainedScenarios {
+		scenario, exists := injector.scenarios[next.scenario]
+		if exists && injector.isScenarioApplicable(scenario, operation) {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextChainedScenario pops the first queued chained scenario that applies to
+// the operation, dropping queued names that no longer match a known scenario
+func (injector *SafeChaosInjector) nextChainedScenario(operation string) *ChaosScenario {
+	index := injector.findChainedScenario(operation)
+	if index < 0 {
+		return nil
+	}
+
+	next := injector.chainedScenarios[index]
+	remaining := make([]chainedFailure, 0, len(injector.chainedScenarios)-1)
+	for i, queued := range injector.chainedScenarios {
+		if _, exists := injector.scenarios[queued.scenario]; i != index && exists {
+			remaining = append(remaining, queued)
+		}
+	}
+	injector.chainedScenarios = remaining
+	injector.activeChain = &next
+	return injector.scenarios[next.scenario]
+}
+
+// takeChainSource returns the scenario that chained into the given one, if it was
+// handed out from the chain queue, and clears the active chain
+func (injector *SafeChaosInjector) takeChainSource(scenario *ChaosScenario) string {
+	active := injector.activeChain
+	injector.activeChain = nil
+
+	if active == nil || scenario.ErrorScenario == nil || active.scenario != scenario.ErrorScenario.Type {
+		return ""
+	}
+	return active.source
+}
+
+// queueChainedFailures queues the scenario's chainable failures as follow-on injections.
+// Only as many are queued as the safety monitor's remaining injection budget allows.
+func (injector *SafeChaosInjector) queueChainedFailures(scenario *ChaosScenario) {
+	if !injector.isChainingActive() || len(scenario.ChainableFailures) == 0 || scenario.ErrorScenario == nil {
+		return
+	}
+
+	budget := injector.safetyMonitor.RemainingInjections() - int64(len(injector.chainedScenarios))
+	for _, name := range scenario.ChainableFailures {
+		if budget <= 0 {
+			return
+		}
+		if _, exists := injector.scenarios[name]; !exists {
+			continue
+		}
+
+		injector.chainedScenarios = append(injector.chainedScenarios, chainedFailure{
+			scenario: name,
+			source:   scenario.ErrorScenario.Type,
+		})
+		budget--
+	}
+}
+
+// PendingChainedScenarios returns the chained scenarios waiting to be injected
+func (injector *SafeChaosInjector) PendingChainedScenarios() []string {
+	injector.mutex.RLock()
+	defer injector.mutex.RUnlock()
+
+	pending := make([]string, len(injector.chainedScenarios))
+	for i, next := range injector.chainedScenarios {
+		pending[i] = next.scenario
+	}
+	return pending
+}
+
 // getApplicableScenarios returns scenarios that apply to the given operation
 func (injector *SafeChaosInjector) getApplicableScenarios(operation string) []*ChaosScenario {
 	candidates := make([]*ChaosScenario, 0)
 
-	for _, scenario := range injector.scenarios {
+	// Walk scenarios in name order so a seeded RNG always sees the same candidates
+	names := make([]string, 0, len(injector.scenarios))
+	for name := range injector.scenarios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		scenario := injector.scenarios[name]
 		if injector.isScenarioApplicable(scenario, operation) {
 			candidates = append(candidates, scenario)
 		}
@@ -336,11 +535,35 @@ func (injector *SafeChaosInjector) getApplicableScenarios(operation string) []*C
 	return candidates
 }
 
-// isScenarioApplicable checks if a scenario applies to the given operation
+// RegisterOperationTags records the categorization tags of an operation for scenario targeting
+func (injector *SafeChaosInjector) RegisterOperationTags(operation string, tags []string) {
+	injector.mutex.Lock()
+	defer injector.mutex.Unlock()
+
+	injector.operationTags[operation] = tags
+}
+
+// isScenarioApplicable checks if a scenario applies to the given operation.
+// Tagged scenarios only apply to operations sharing at least one tag; untagged
+// scenarios and operations with no registered tags match anything.
 func (injector *SafeChaosInjector) isScenarioApplicable(scenario *ChaosScenario, operation string) bool {
-	// For now, implement basic matching logic
-	// In a full implementation, this would be more sophisticated
-	return scenario.ErrorScenario != nil
+	if scenario.ErrorScenario == nil {
+		return false
+	}
+
+	operationTags, registered := injector.operationTags[operation]
+	if len(scenario.Tags) == 0 || !registered {
+		return true
+	}
+
+	for _, scenarioTag := range scenario.Tags {
+		for _, operationTag := range operationTags {
+			if scenarioTag == operationTag {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // filterScenariosBySkill filters scenarios based on user skill level
@@ -440,7 +663,7 @@ func (injector *SafeChaosInjector) InjectFailure(operation string, scenario *Cha
 	}
 
 	// Execute the scenario (simulation only)
-	err := injector.simulateFailure(scenario)
+	err := injector.simulateFailure(operation, scenario)
 
 	// Record injection event
 	event := InjectionEvent{
@@ -452,13 +675,18 @@ func (injector *SafeChaosInjector) InjectFailure(operation string, scenario *Cha
 	}
 
 	injector.mutex.Lock()
+	event.ChainedFrom = injector.takeChainSource(scenario)
 	injector.operationLog = append(injector.operationLog, event)
 	injector.metrics.TotalInjections++
 	if err == nil {
 		injector.metrics.SuccessfulInjections++
 	}
+	injector.queueChainedFailures(scenario)
 	injector.mutex.Unlock()
 
+	// Forward to the audit trail
+	injector.safetyMonitor.RecordTelemetry(event)
+
 	return err
 }
 
@@ -473,7 +701,7 @@ func (injector *SafeChaosInjector) ExecuteScenario(operation string) error {
 }
 
 // simulateFailure simulates a failure scenario without actual system impact
-func (injector *SafeChaosInjector) simulateFailure(scenario *ChaosScenario) error {
+func (injector *SafeChaosInjector) simulateFailure(operation string, scenario *ChaosScenario) error {
 	// This is where the actual chaos simulation would happen
 	// For now, we'll implement basic simulation logic
 
@@ -497,11 +725,39 @@ func (injector *SafeChaosInjector) simulateFailure(scenario *ChaosScenario) erro
 	time.Sleep(duration)
 
 	// Return the simulated error (this would integrate with existing error scenarios)
+	if scenario.MessageTemplate != "" {
+		return errors.New(injector.renderFailureMessage(operation, scenario))
+	}
 	return fmt.Errorf("CHAOS INJECTION: %s - %s",
 		scenario.ErrorScenario.Type,
 		scenario.ErrorScenario.Message)
 }
 
+// renderFailureMessage substitutes the scenario's message template variables
+func (injector *SafeChaosInjector) renderFailureMessage(operation string, scenario *ChaosScenario) string {
+	replacer := strings.NewReplacer(
+		"{{operation}}", operation,
+		"{{attempt}}", strconv.Itoa(injector.injectionAttempt(operation)),
+		"{{type}}", scenario.ErrorScenario.Type,
+		"{{message}}", scenario.ErrorScenario.Message,
+	)
+	return replacer.Replace(scenario.MessageTemplate)
+}
+
+// injectionAttempt returns the 1-based attempt number of the injection in progress for an operation
+func (injector *SafeChaosInjector) injectionAttempt(operation string) int {
+	injector.mutex.RLock()
+	defer injector.mutex.RUnlock()
+
+	attempt := 1
+	for _, event := range injector.operationLog {
+		if event.Operation == operation {
+			attempt++
+		}
+	}
+	return attempt
+}
+
 // RecordUserAction records a user action for behavior analysis
 func (injector *SafeChaosInjector) RecordUserAction(action UserAction) error {
 	return injector.userBehavior.RecordAction(action)
@@ -537,6 +793,42 @@ func (injector *SafeChaosInjector) AdjustDifficulty(pattern *BehaviorPattern) Ag
 	return currentLevel
 }
 
+// DifficultyPreview describes the aggressiveness a learner is likely to experience
+type DifficultyPreview struct {
+	Configured AggressivenessLevel
+	Expected   AggressivenessLevel
+	SkillLevel SkillLevel
+}
+
+// PreviewDifficulty applies AdjustDifficulty to the learner's behavior pattern
+// so the expected aggressiveness can be shown before a run starts. A fresh
+// injector has no history yet, so prior (typically the pattern from the last
+// exported session) is used in its place when given.
+func PreviewDifficulty(injector ChaosInjector, prior *BehaviorPattern) DifficultyPreview {
+	pattern := prior
+	if pattern == nil {
+		pattern = injector.AnalyzeBehaviorPattern()
+	}
+
+	preview := DifficultyPreview{
+		Configured: injector.GetAggressivenessLevel(),
+		Expected:   injector.AdjustDifficulty(pattern),
+	}
+	if pattern != nil {
+		preview.SkillLevel = pattern.SkillLevel
+	}
+	return preview
+}
+
+// String formats the preview as a single pre-run line
+func (p DifficultyPreview) String() string {
+	if p.Expected == p.Configured {
+		return fmt.Sprintf("Chaos difficulty: %s (%s learner)", p.Configured.String(), p.SkillLevel.String())
+	}
+	return fmt.Sprintf("Chaos difficulty: %s, likely adapting to %s (%s learner)",
+		p.Configured.String(), p.Expected.String(), p.SkillLevel.String())
+}
+
 // ValidateSafetyBoundaries validates all safety boundaries are intact
 func (injector *SafeChaosInjector) ValidateSafetyBoundaries() error {
 	return injector.safetyMonitor.PerformHealthCheck()
@@ -569,11 +861,15 @@ func (injector *SafeChaosInjector) ResetState() error {
 	// Clear operation log
 	injector.operationLog = make([]InjectionEvent, 0)
 
+	// Clear pending chained failures
+	injector.chainedScenarios = nil
+	injector.activeChain = nil
+
 	// Reset metrics
 	injector.metrics = &InjectionMetrics{}
 
 	// Reset start time
-	injector.startTime = time.Now()
+	injector.startTime = injector.now()
 
 	return nil
 }
@@ -595,6 +891,8 @@ func initializeDefaultScenarios() map[string]*ChaosScenario {
 			Advanced:     1.0,
 			Expert:       1.2,
 		},
+		ChainableFailures: []string{"dependency_conflict", "resource_exhausted"},
+		Tags:              []string{"network"},
 		LearningObjectives: []string{
 			"Understanding network troubleshooting",
 			"Learning timeout handling",
@@ -610,6 +908,7 @@ func initializeDefaultScenarios() map[string]*ChaosScenario {
 			Type:    "permission_denied",
 			Message: "Permission denied: insufficient privileges",
 		},
+		Tags:               []string{"filesystem", "config"},
 		TriggerProbability: 0.25,
 		UserSkillModifier: map[SkillLevel]float64{
 			Novice:       0.8,
@@ -632,6 +931,7 @@ func initializeDefaultScenarios() map[string]*ChaosScenario {
 			Type:    "resource_exhausted",
 			Message: "Insufficient disk space or memory",
 		},
+		Tags:               []string{"dependencies", "build", "filesystem"},
 		TriggerProbability: 0.2,
 		UserSkillModifier: map[SkillLevel]float64{
 			Novice:       0.3,
@@ -648,6 +948,29 @@ func initializeDefaultScenarios() map[string]*ChaosScenario {
 	}
 	scenarios["resource_exhausted"] = resourceScenario
 
+	// Dependency conflict scenario
+	dependencyScenario := &ChaosScenario{
+		ErrorScenario: &ErrorScenario{
+			Type:    "dependency_conflict",
+			Message: "Conflicting peer dependency versions could not be resolved",
+		},
+		Tags:               []string{"dependencies"},
+		TriggerProbability: 0.2,
+		UserSkillModifier: map[SkillLevel]float64{
+			Novice:       0.4,
+			Intermediate: 0.7,
+			Advanced:     1.0,
+			Expert:       1.2,
+		},
+		LearningObjectives: []string{
+			"Understanding dependency resolution",
+			"Learning lockfile and version pinning",
+		},
+		MinDuration: 200 * time.Millisecond,
+		MaxDuration: 1500 * time.Millisecond,
+	}
+	scenarios["dependency_conflict"] = dependencyScenario
+
 	return scenarios
 }
 
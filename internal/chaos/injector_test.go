@@ -0,0 +1,418 @@
+package chaos
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestInjector builds an enabled, seeded injector whose scenarios resolve
+// instantly; mutate adjusts the configuration before construction
+func newTestInjector(t *testing.T, mutate func(*ChaosConfig)) *SafeChaosInjector {
+	t.Helper()
+
+	config := NewDefaultConfig()
+	config.Enabled = true
+	config.RandomSeed = 1
+	config.MaxCPUUsagePercent = 50
+	if mutate != nil {
+		mutate(config)
+	}
+
+	injector, err := NewSafeChaosInjector(config)
+	if err != nil {
+		t.Fatalf("NewSafeChaosInjector() error = %v", err)
+	}
+	for _, scenario := range injector.scenarios {
+		scenario.MinDuration = time.Microsecond
+		scenario.MaxDuration = time.Microsecond
+	}
+	return injector
+}
+
+func TestFailureChainingQueuesFollowOnForNextOperation(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Installing dependencies", []string{"network", "dependencies"})
+
+	if injector.ShouldInject("Installing dependencies") {
+		t.Fatal("ShouldInject() = true before any failure at level off")
+	}
+
+	if err := injector.InjectFailure("Initializing project", injector.scenarios["network_failure"]); err == nil {
+		t.Fatal("InjectFailure() error = nil, want the simulated failure")
+	}
+
+	if pending := injector.PendingChainedScenarios(); len(pending) == 0 {
+		t.Fatal("PendingChainedScenarios() is empty after network_failure")
+	}
+
+	inject, reason := injector.ShouldInjectTraced("Installing dependencies")
+	if !inject {
+		t.Fatalf("ShouldInjectTraced() = false (%s), want the chained failure forced", reason)
+	}
+
+	scenario := injector.SelectScenario("Installing dependencies")
+	if scenario == nil || scenario.ErrorScenario.Type != "dependency_conflict" {
+		t.Fatalf("SelectScenario() = %v, want dependency_conflict", scenario)
+	}
+}
+
+func TestFailureChainingWaitsForApplicableOperation(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Setting up documentation", []string{"docs"})
+	injector.RegisterOperationTags("Installing dependencies", []string{"network", "dependencies"})
+
+	injector.InjectFailure("Initializing project", injector.scenarios["network_failure"])
+	before := injector.PendingChainedScenarios()
+
+	if inject, reason := injector.ShouldInjectTraced("Setting up documentation"); inject {
+		t.Fatalf("ShouldInjectTraced() = true (%s) for an operation no chained scenario applies to", reason)
+	}
+	if scenario := injector.SelectScenario("Setting up documentation"); scenario != nil && scenario.ErrorScenario.Type == before[0] {
+		t.Fatalf("SelectScenario() consumed chained %s on an unrelated operation", before[0])
+	}
+	if after := injector.PendingChainedScenarios(); len(after) != len(before) {
+		t.Fatalf("PendingChainedScenarios() = %v, want %v left queued", after, before)
+	}
+
+	if scenario := injector.SelectScenario("Installing dependencies"); scenario == nil || scenario.ErrorScenario.Type != before[0] {
+		t.Fatalf("SelectScenario() = %v, want queued %s", scenario, before[0])
+	}
+}
+
+func TestFailureChainingSkipsQueuedScenarioThatNeverApplies(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Generating project structure", []string{"filesystem"})
+
+	// network_failure queues dependency_conflict then resource_exhausted; the
+	// install step has already passed, so dependency_conflict never applies
+	injector.InjectFailure("Installing dependencies", injector.scenarios["network_failure"])
+	if pending := injector.PendingChainedScenarios(); !reflect.DeepEqual(pending, []string{"dependency_conflict", "resource_exhausted"}) {
+		t.Fatalf("PendingChainedScenarios() = %v, want dependency_conflict then resource_exhausted", pending)
+	}
+
+	scenario := injector.SelectScenario("Generating project structure")
+	if scenario == nil || scenario.ErrorScenario.Type != "resource_exhausted" {
+		t.Fatalf("SelectScenario() = %v, want resource_exhausted from behind the inapplicable head", scenario)
+	}
+	if pending := injector.PendingChainedScenarios(); !reflect.DeepEqual(pending, []string{"dependency_conflict"}) {
+		t.Errorf("PendingChainedScenarios() = %v, want only dependency_conflict left", pending)
+	}
+}
+
+func TestFailureChainingDropsUnknownQueuedScenarios(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Installing dependencies", []string{"network", "dependencies"})
+
+	injector.InjectFailure("Initializing project", injector.scenarios["network_failure"])
+	injector.chainedScenarios = append([]chainedFailure{{scenario: "retired_scenario", source: "network_failure"}}, injector.chainedScenarios...)
+
+	scenario := injector.SelectScenario("Installing dependencies")
+	if scenario == nil || scenario.ErrorScenario.Type != "dependency_conflict" {
+		t.Fatalf("SelectScenario() = %v, want dependency_conflict past the unknown head", scenario)
+	}
+	if pending := injector.PendingChainedScenarios(); !reflect.DeepEqual(pending, []string{"resource_exhausted"}) {
+		t.Errorf("PendingChainedScenarios() = %v, want the unknown scenario dropped", pending)
+	}
+}
+
+func TestFailureChainingDisabledByCascadePrevention(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = true
+	})
+
+	injector.InjectFailure("Initializing project", injector.scenarios["network_failure"])
+
+	if pending := injector.PendingChainedScenarios(); len(pending) != 0 {
+		t.Fatalf("PendingChainedScenarios() = %v, want none while cascade prevention is on", pending)
+	}
+	if injector.ShouldInject("Installing dependencies") {
+		t.Fatal("ShouldInject() = true, want no forced failure while cascade prevention is on")
+	}
+}
+
+func TestChainedInjectionIsRecordedAsItsOwnEvent(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Installing dependencies", []string{"network", "dependencies"})
+
+	injector.InjectFailure("Initializing project", injector.scenarios["network_failure"])
+	scenario := injector.SelectScenario("Installing dependencies")
+	if scenario == nil {
+		t.Fatal("SelectScenario() = nil, want the chained scenario")
+	}
+	injector.InjectFailure("Installing dependencies", scenario)
+
+	history := injector.GetOperationHistory()
+	if len(history) != 2 {
+		t.Fatalf("recorded %d injection events, want the source and the chained failure", len(history))
+	}
+	if history[0].Scenario != "network_failure" || history[0].ChainedFrom != "" {
+		t.Errorf("first event = %+v, want an unchained network_failure", history[0])
+	}
+	if history[1].Scenario != "dependency_conflict" || history[1].ChainedFrom != "network_failure" {
+		t.Errorf("second event = %+v, want dependency_conflict chained from network_failure", history[1])
+	}
+
+	// A scenario injected directly is not attributed to an earlier chain
+	injector.InjectFailure("Installing dependencies", injector.scenarios["dependency_conflict"])
+	if last := injector.GetOperationHistory()[2]; last.ChainedFrom != "" {
+		t.Errorf("direct injection ChainedFrom = %q, want none", last.ChainedFrom)
+	}
+}
+
+func TestChainedScenarioBehindInapplicableHeadIsForcedAndRecorded(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+		c.FailureChaining = true
+		c.CascadePrevent = false
+	})
+	injector.RegisterOperationTags("Generating project structure", []string{"filesystem"})
+
+	// dependency_conflict heads the queue but can't apply to a filesystem step
+	injector.InjectFailure("Installing dependencies", injector.scenarios["network_failure"])
+
+	inject, reason := injector.ShouldInjectTraced("Generating project structure")
+	if !inject || !strings.Contains(reason, "resource_exhausted") {
+		t.Fatalf("ShouldInjectTraced() = %t (%s), want resource_exhausted forced", inject, reason)
+	}
+	scenario := injector.SelectScenario("Generating project structure")
+	if scenario == nil || scenario.ErrorScenario.Type != "resource_exhausted" {
+		t.Fatalf("SelectScenario() = %v, want resource_exhausted", scenario)
+	}
+	injector.InjectFailure("Generating project structure", scenario)
+
+	history := injector.GetOperationHistory()
+	if last := history[len(history)-1]; last.Scenario != "resource_exhausted" || last.ChainedFrom != "network_failure" {
+		t.Errorf("last event = %+v, want resource_exhausted chained from network_failure", last)
+	}
+}
+
+func TestFailureChainingRespectsInjectionBudget(t *testing.T) {
+	tests := []struct {
+		maxInjections int64
+		want          []string
+	}{
+		{maxInjections: 1000, want: []string{"dependency_conflict", "resource_exhausted"}},
+		{maxInjections: 2, want: []string{"dependency_conflict"}},
+		{maxInjections: 1, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		injector := newTestInjector(t, func(c *ChaosConfig) {
+			c.AggressivenessLevel = Off
+			c.FailureChaining = true
+			c.CascadePrevent = false
+			c.MaxInjectionCount = tt.maxInjections
+		})
+
+		injector.InjectFailure("Initializing project", injector.scenarios["network_failure"])
+		if pending := injector.PendingChainedScenarios(); !reflect.DeepEqual(pending, tt.want) {
+			t.Errorf("max %d injections: PendingChainedScenarios() = %v, want %v", tt.maxInjections, pending, tt.want)
+		}
+	}
+}
+
+func TestScenarioApplicabilityMatchesTags(t *testing.T) {
+	injector := newTestInjector(t, nil)
+	injector.RegisterOperationTags("Installing dependencies", []string{"network", "dependencies"})
+	injector.RegisterOperationTags("Generating project structure", []string{"filesystem"})
+
+	network := injector.scenarios["network_failure"]
+	if !injector.isScenarioApplicable(network, "Installing dependencies") {
+		t.Error("network_failure does not apply to a network-tagged step")
+	}
+	if injector.isScenarioApplicable(network, "Generating project structure") {
+		t.Error("network_failure applies to a step without the network tag")
+	}
+	if !injector.isScenarioApplicable(network, "Unregistered operation") {
+		t.Error("network_failure does not apply to an operation with no registered tags")
+	}
+
+	for _, scenario := range injector.getApplicableScenarios("Generating project structure") {
+		if scenario.ErrorScenario.Type == "network_failure" {
+			t.Error("getApplicableScenarios() includes network_failure for a filesystem step")
+		}
+	}
+}
+
+func TestMessageTemplateSubstitutesVariables(t *testing.T) {
+	injector := newTestInjector(t, nil)
+	scenario := injector.scenarios["network_failure"]
+	scenario.MessageTemplate = "{{operation}} failed on attempt {{attempt}}: {{type}} ({{message}})"
+
+	want := func(attempt string) string {
+		return "Installing dependencies failed on attempt " + attempt + ": " +
+			scenario.ErrorScenario.Type + " (" + scenario.ErrorScenario.Message + ")"
+	}
+
+	for _, attempt := range []string{"1", "2"} {
+		err := injector.InjectFailure("Installing dependencies", scenario)
+		if err == nil {
+			t.Fatal("InjectFailure() error = nil, want the templated failure")
+		}
+		if err.Error() != want(attempt) {
+			t.Errorf("InjectFailure() error = %q, want %q", err.Error(), want(attempt))
+		}
+	}
+}
+
+func TestMessageTemplateFallsBackToDefaultFormat(t *testing.T) {
+	injector := newTestInjector(t, nil)
+	scenario := injector.scenarios["network_failure"]
+
+	err := injector.InjectFailure("Installing dependencies", scenario)
+	if err == nil {
+		t.Fatal("InjectFailure() error = nil, want the simulated failure")
+	}
+	want := "CHAOS INJECTION: " + scenario.ErrorScenario.Type + " - " + scenario.ErrorScenario.Message
+	if err.Error() != want {
+		t.Errorf("InjectFailure() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestPreviewDifficultyEscalatesForExpertPattern(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Scout
+	})
+
+	before := PreviewDifficulty(injector, nil)
+	if before.Expected != Scout {
+		t.Fatalf("Expected = %s before any actions, want scout", before.Expected)
+	}
+
+	// Seed the skill data a returning expert would carry: fast resolutions
+	// and a run of successful recoveries
+	injector.userBehavior.competenceMetrics.AverageResolutionTime = 30 * time.Second
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		action := UserAction{
+			Timestamp:  start.Add(time.Duration(i) * 10 * time.Second),
+			ActionType: RecoveryAction,
+			Command:    "npm install",
+			Success:    true,
+			Duration:   time.Second,
+		}
+		if err := injector.RecordUserAction(action); err != nil {
+			t.Fatalf("RecordUserAction() error = %v", err)
+		}
+	}
+
+	preview := PreviewDifficulty(injector, nil)
+	if preview.SkillLevel != Expert {
+		t.Fatalf("SkillLevel = %s, want expert", preview.SkillLevel)
+	}
+	if preview.Configured != Scout || preview.Expected != Aggressive {
+		t.Errorf("preview = %s -> %s, want scout -> aggressive", preview.Configured, preview.Expected)
+	}
+	if want := "Chaos difficulty: scout, likely adapting to aggressive (expert learner)"; preview.String() != want {
+		t.Errorf("String() = %q, want %q", preview.String(), want)
+	}
+}
+
+func TestPreviewDifficultyUsesPriorSessionPattern(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Scout
+	})
+
+	tests := []struct {
+		name  string
+		prior *BehaviorPattern
+		want  AggressivenessLevel
+	}{
+		{name: "no prior session", prior: nil, want: Scout},
+		{name: "expert last time", prior: &BehaviorPattern{SkillLevel: Expert, RecentSuccessRate: 0.95}, want: Aggressive},
+		{name: "struggled last time", prior: &BehaviorPattern{SkillLevel: Novice, RecentSuccessRate: 0.2}, want: Default},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preview := PreviewDifficulty(injector, tt.prior)
+			if preview.Configured != Scout || preview.Expected != tt.want {
+				t.Errorf("preview = %s -> %s, want scout -> %s", preview.Configured, preview.Expected, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplicableScenariosOrderedByName(t *testing.T) {
+	scenarioNames := func() []string {
+		injector := newTestInjector(t, nil)
+		var names []string
+		for _, scenario := range injector.getApplicableScenarios("Installing dependencies") {
+			names = append(names, scenario.ErrorScenario.Type)
+		}
+		return names
+	}
+
+	first, second := scenarioNames(), scenarioNames()
+	if len(first) < 2 {
+		t.Fatalf("only %d applicable scenarios, want several to order", len(first))
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("scenario order differs between runs: %v vs %v", first, second)
+	}
+	if !sort.StringsAreSorted(first) {
+		t.Errorf("scenarios %v are not in name order", first)
+	}
+}
+
+func TestChaosOffAfterStopsInjections(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.OffAfter = 5 * time.Second
+	})
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	injector.SetClock(func() time.Time { return now })
+
+	if inject, reason := injector.ShouldInjectTraced("Installing dependencies"); !inject {
+		t.Fatalf("ShouldInjectTraced() = false (%s) inside the chaos window", reason)
+	}
+
+	now = now.Add(4 * time.Second)
+	if !injector.ShouldInject("Installing dependencies") || !injector.IsEnabled() {
+		t.Fatal("chaos switched off before OffAfter elapsed")
+	}
+
+	now = now.Add(time.Second)
+	inject, reason := injector.ShouldInjectTraced("Installing dependencies")
+	if inject {
+		t.Fatal("ShouldInjectTraced() = true after OffAfter elapsed")
+	}
+	if want := "chaos switched off after 5s"; reason != want {
+		t.Errorf("reason = %q, want %q", reason, want)
+	}
+	if injector.IsEnabled() {
+		t.Error("IsEnabled() = true after OffAfter elapsed")
+	}
+
+	// MarkStart restarts the window, e.g. once prompts finish
+	injector.MarkStart()
+	if !injector.ShouldInject("Installing dependencies") {
+		t.Error("ShouldInject() = false after MarkStart restarted the window")
+	}
+}
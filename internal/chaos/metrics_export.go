@@ -0,0 +1,131 @@
+package chaos
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// metricsFilePrefix marks files written by ExportMetrics; retention pruning
+// only ever removes files whose names start with it
+const metricsFilePrefix = "chaos-metrics"
+
+// MetricsFileName returns a timestamped file name for exporting a session's
+// metrics that retention pruning will recognize
+func MetricsFileName(at time.Time) string {
+	return fmt.Sprintf("%s-%s.json", metricsFilePrefix, at.Format("20060102-150405"))
+}
+
+// ExportMetrics writes the session's chaos metrics, including injection and
+// adaptation history, to path as JSON. Exported metrics files in the same
+// directory older than MetricsRetentionDays are then removed.
+func (cat *ChaosAwareTracker) ExportMetrics(path string) error {
+	data, err := json.MarshalIndent(cat.GetChaosMetrics(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal chaos metrics: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create metrics directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write metrics file: %w", err)
+	}
+
+	if cat.chaosInjector == nil {
+		return nil
+	}
+	config := cat.chaosInjector.GetConfig()
+	if config == nil || config.MetricsRetentionDays <= 0 {
+		return nil
+	}
+
+	retention := time.Duration(config.MetricsRetentionDays) * 24 * time.Hour
+	if err := pruneMetricsFiles(dir, path, time.Now().Add(-retention)); err != nil {
+		return fmt.Errorf("failed to prune old metrics files: %w", err)
+	}
+
+	return nil
+}
+
+// pruneMetricsFiles removes exported metrics files in dir last modified before
+// cutoff, always keeping the file at keep
+func pruneMetricsFiles(dir, keep string, cutoff time.Time) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, metricsFilePrefix) || filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		if filepath.Clean(path) == filepath.Clean(keep) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(cutoff) {
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMetrics reads chaos metrics previously written by ExportMetrics
+func LoadMetrics(path string) (*ChaosMetrics, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics file: %w", err)
+	}
+
+	var metrics ChaosMetrics
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to parse metrics file: %w", err)
+	}
+
+	return &metrics, nil
+}
+
+// LoadLatestMetrics reads the most recent metrics file ExportMetrics wrote to
+// dir. It returns nil without error when dir holds no exported metrics yet.
+func LoadLatestMetrics(dir string) (*ChaosMetrics, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read metrics directory: %w", err)
+	}
+
+	// Timestamped names sort chronologically, so the last match is the newest
+	latest := ""
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, metricsFilePrefix) || filepath.Ext(name) != ".json" {
+			continue
+		}
+		if name > latest {
+			latest = name
+		}
+	}
+	if latest == "" {
+		return nil, nil
+	}
+
+	return LoadMetrics(filepath.Join(dir, latest))
+}
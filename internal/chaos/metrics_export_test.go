@@ -0,0 +1,155 @@
+package chaos
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+)
+
+// newMetricsTracker runs every step with forced chaos so the tracker has
+// injection and adaptation history to export
+func newMetricsTracker(t *testing.T, mutate func(*ChaosConfig)) *ChaosAwareTracker {
+	t.Helper()
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), newTestInjector(t, func(c *ChaosConfig) {
+		c.CascadePrevent = false
+		if mutate != nil {
+			mutate(c)
+		}
+	}))
+	for i := 0; i < tracker.TotalSteps(); i++ {
+		tracker.ExecuteStep(i)
+	}
+	tracker.adaptationLog = append(tracker.adaptationLog, AdaptationEvent{
+		Timestamp:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		PreviousLevel: Default,
+		NewLevel:      Scout,
+		Reason:        "user struggling",
+	})
+	return tracker
+}
+
+// mustMarshalJSON encodes v for comparing metrics across a round trip
+func mustMarshalJSON(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	return data
+}
+
+func TestExportMetricsRoundTrip(t *testing.T) {
+	tracker := newMetricsTracker(t, nil)
+	path := filepath.Join(t.TempDir(), "reports", MetricsFileName(time.Now()))
+
+	if err := tracker.ExportMetrics(path); err != nil {
+		t.Fatalf("ExportMetrics() error = %v", err)
+	}
+	loaded, err := LoadMetrics(path)
+	if err != nil {
+		t.Fatalf("LoadMetrics() error = %v", err)
+	}
+
+	want := tracker.GetChaosMetrics()
+	if len(want.InjectionHistory) == 0 {
+		t.Fatal("tracker has no injection history; the test did not exercise it")
+	}
+	if len(loaded.InjectionHistory) != len(want.InjectionHistory) || len(loaded.AdaptationHistory) != len(want.AdaptationHistory) {
+		t.Errorf("loaded %d injections and %d adaptations, want %d and %d",
+			len(loaded.InjectionHistory), len(loaded.AdaptationHistory), len(want.InjectionHistory), len(want.AdaptationHistory))
+	}
+	if got, want := mustMarshalJSON(t, loaded), mustMarshalJSON(t, want); !bytes.Equal(got, want) {
+		t.Errorf("metrics changed across export and load:\ngot  %s\nwant %s", got, want)
+	}
+}
+
+func TestExportMetricsPrunesOnlyOldMetricsFiles(t *testing.T) {
+	dir := t.TempDir()
+	old := time.Now().Add(-10 * 24 * time.Hour)
+	recent := time.Now().Add(-24 * time.Hour)
+
+	files := []struct {
+		name     string
+		modTime  time.Time
+		wantKept bool
+	}{
+		{name: "chaos-metrics-20240101-120000.json", modTime: old, wantKept: false},
+		{name: "chaos-metrics-recent.json", modTime: recent, wantKept: true},
+		{name: "chaos-metrics-notes.txt", modTime: old, wantKept: true},
+		{name: "cohort-report.json", modTime: old, wantKept: true},
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, f.name)
+		if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+			t.Fatalf("writing %s: %v", f.name, err)
+		}
+		if err := os.Chtimes(path, f.modTime, f.modTime); err != nil {
+			t.Fatalf("backdating %s: %v", f.name, err)
+		}
+	}
+
+	tracker := newMetricsTracker(t, func(c *ChaosConfig) { c.MetricsRetentionDays = 7 })
+	exported := filepath.Join(dir, MetricsFileName(time.Now()))
+	if err := tracker.ExportMetrics(exported); err != nil {
+		t.Fatalf("ExportMetrics() error = %v", err)
+	}
+
+	for _, f := range files {
+		_, err := os.Stat(filepath.Join(dir, f.name))
+		if kept := err == nil; kept != f.wantKept {
+			t.Errorf("%s kept = %t, want %t", f.name, kept, f.wantKept)
+		}
+	}
+	if _, err := os.Stat(exported); err != nil {
+		t.Errorf("exported metrics file is missing: %v", err)
+	}
+}
+
+func TestLoadMetricsRejectsBadFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := LoadMetrics(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("LoadMetrics() of a missing file error = nil")
+	}
+
+	corrupt := filepath.Join(dir, "chaos-metrics-corrupt.json")
+	if err := os.WriteFile(corrupt, []byte("{not json"), 0644); err != nil {
+		t.Fatalf("writing corrupt metrics: %v", err)
+	}
+	if _, err := LoadMetrics(corrupt); err == nil {
+		t.Error("LoadMetrics() of malformed JSON error = nil")
+	}
+}
+
+func TestLoadLatestMetricsPicksNewestExport(t *testing.T) {
+	dir := t.TempDir()
+	if metrics, err := LoadLatestMetrics(filepath.Join(dir, "missing")); err != nil || metrics != nil {
+		t.Fatalf("LoadLatestMetrics() of a missing dir = %v, %v; want nil, nil", metrics, err)
+	}
+
+	files := map[string]string{
+		"chaos-metrics-20240101-120000.json": `{"current_session": "older"}`,
+		"chaos-metrics-20240301-090000.json": `{"current_session": "newest"}`,
+		"chaos-metrics-notes.txt":            `not metrics`,
+		"cohort-report.json":                 `{"current_session": "unrelated"}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	metrics, err := LoadLatestMetrics(dir)
+	if err != nil {
+		t.Fatalf("LoadLatestMetrics() error = %v", err)
+	}
+	if metrics == nil || metrics.CurrentSession != "newest" {
+		t.Errorf("LoadLatestMetrics() = %+v, want the newest export", metrics)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !unix
+
+package chaos
+
+import "time"
+
+// processCPUTime reports that CPU sampling is unsupported on platforms without
+// getrusage, which leaves the CPU limit unenforced there
+func processCPUTime() (time.Duration, bool) {
+	return 0, false
+}
@@ -21,6 +21,7 @@ type SafetyMonitor struct {
 	systemSnapshot   *SystemSnapshot
 	resourceMonitor  *ResourceMonitor
 	healthCheck      *HealthChecker
+	telemetry        TelemetrySink
 }
 
 // SystemSnapshot captures the current system state for integrity verification
@@ -39,8 +40,19 @@ type ResourceMonitor struct {
 	maxCPUPercent    float64
 	operationTimeout time.Duration
 	startTime        time.Time
+
+	// CPU sampling state, guarded by cpuMutex since checks run under a read lock
+	cpuMutex      sync.Mutex
+	cpuSupported  bool
+	lastCPUTime   time.Duration
+	lastCPUSample time.Time
+	cpuPercent    float64
 }
 
+// cpuSampleWindow is the shortest wall-clock window CPU usage is measured over;
+// checks inside the window reuse the previous measurement
+const cpuSampleWindow = 250 * time.Millisecond
+
 // HealthChecker provides continuous monitoring of system integrity
 type HealthChecker struct {
 	lastCheck        time.Time
@@ -97,6 +109,8 @@ func NewSafetyMonitor(config *ChaosConfig) (*SafetyMonitor, error) {
 		operationTimeout: config.GetOperationTimeout(),
 		startTime:        time.Now(),
 	}
+	resourceMonitor.lastCPUTime, resourceMonitor.cpuSupported = processCPUTime()
+	resourceMonitor.lastCPUSample = resourceMonitor.startTime
 
 	// Initialize health checker
 	healthChecker := &HealthChecker{
@@ -113,6 +127,7 @@ func NewSafetyMonitor(config *ChaosConfig) (*SafetyMonitor, error) {
 		systemSnapshot:  snapshot,
 		resourceMonitor: resourceMonitor,
 		healthCheck:     healthChecker,
+		telemetry:       NewTelemetrySink(config),
 	}
 
 	return monitor, nil
@@ -310,8 +325,21 @@ func (sm *SafetyMonitor) checkResourceLimits() error {
 			currentMemoryMB, sm.config.MaxMemoryUsageMB)
 	}
 
-	// CPU usage check would require more complex monitoring
-	// For now, we implement a simple goroutine count check as a proxy
+	// Check CPU limit over the most recent sampling window
+	if cpuPercent, ok := sm.resourceMonitor.sampleCPU(); ok && cpuPercent > sm.config.MaxCPUUsagePercent {
+		violation := SafetyViolation{
+			Type:        ResourceViolation,
+			Description: fmt.Sprintf("CPU usage exceeded: %.1f%% > %.1f%%", cpuPercent, sm.config.MaxCPUUsagePercent),
+			Severity:    Critical,
+			Timestamp:   time.Now(),
+			Action:      "Operation blocked",
+		}
+		sm.recordViolation(violation)
+		return fmt.Errorf("SAFETY VIOLATION: CPU usage exceeded limit (%.1f%% > %.1f%%)",
+			cpuPercent, sm.config.MaxCPUUsagePercent)
+	}
+
+	// Runaway goroutines are flagged separately from CPU time
 	numGoroutines := runtime.NumGoroutine()
 	if numGoroutines > 100 { // Arbitrary limit for chaos operations
 		violation := SafetyViolation{
@@ -327,6 +355,35 @@ func (sm *SafetyMonitor) checkResourceLimits() error {
 	return nil
 }
 
+// sampleCPU returns the process CPU usage as a percentage of all cores since
+// the previous sample, and false on platforms that cannot measure it
+func (rm *ResourceMonitor) sampleCPU() (float64, bool) {
+	if !rm.cpuSupported {
+		return 0, false
+	}
+
+	rm.cpuMutex.Lock()
+	defer rm.cpuMutex.Unlock()
+
+	now := time.Now()
+	wall := now.Sub(rm.lastCPUSample)
+	if wall < cpuSampleWindow {
+		return rm.cpuPercent, true
+	}
+
+	cpuTime, ok := processCPUTime()
+	if !ok {
+		return rm.cpuPercent, true
+	}
+
+	capacity := float64(wall) * float64(runtime.NumCPU())
+	rm.cpuPercent = float64(cpuTime-rm.lastCPUTime) / capacity * 100
+	rm.lastCPUTime = cpuTime
+	rm.lastCPUSample = now
+
+	return rm.cpuPercent, true
+}
+
 // RecordInjection records a chaos injection for tracking and limits
 func (sm *SafetyMonitor) RecordInjection(operation string) error {
 	sm.mutex.Lock()
@@ -338,14 +395,37 @@ func (sm *SafetyMonitor) RecordInjection(operation string) error {
 
 	sm.injectionCount++
 
-	// Log injection for audit trail
-	if sm.config.TelemetryEnabled {
-		// In a real implementation, this would go to a proper logging system
-		fmt.Printf("[CHAOS AUDIT] Injection #%d: %s at %v\n",
-			sm.injectionCount, operation, time.Now().Format(time.RFC3339))
+	return nil
+}
+
+// RemainingInjections returns how many more injections fit within MaxInjectionCount
+func (sm *SafetyMonitor) RemainingInjections() int64 {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	remaining := sm.config.MaxInjectionCount - sm.injectionCount
+	if remaining < 0 {
+		return 0
 	}
+	return remaining
+}
 
-	return nil
+// RecordTelemetry sends a completed injection event to the telemetry sink
+func (sm *SafetyMonitor) RecordTelemetry(event InjectionEvent) {
+	sm.mutex.RLock()
+	sink := sm.telemetry
+	sm.mutex.RUnlock()
+
+	if sink != nil {
+		sink.Record(event)
+	}
+}
+
+// SetTelemetrySink replaces the telemetry sink used for the audit trail
+func (sm *SafetyMonitor) SetTelemetrySink(sink TelemetrySink) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	sm.telemetry = sink
 }
 
 // recordViolation records a safety violation
@@ -495,7 +575,7 @@ type ResourceUsage struct {
 	MemoryMB      int64
 	MaxMemoryMB   int64
 	GoroutineCount int
-	CPUPercent    float64 // Placeholder for future implementation
+	CPUPercent    float64 // Share of all cores over the last sample window (0 where unsupported)
 }
 
 // getResourceUsage returns current resource usage statistics
@@ -503,12 +583,13 @@ func (sm *SafetyMonitor) getResourceUsage() ResourceUsage {
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
 	currentMemoryMB := int64(m.Alloc) / 1024 / 1024
+	cpuPercent, _ := sm.resourceMonitor.sampleCPU()
 
 	return ResourceUsage{
 		MemoryMB:       currentMemoryMB,
 		MaxMemoryMB:    sm.config.MaxMemoryUsageMB,
 		GoroutineCount: runtime.NumGoroutine(),
-		CPUPercent:     0.0, // Placeholder - would need platform-specific implementation
+		CPUPercent:     cpuPercent,
 	}
 }
 
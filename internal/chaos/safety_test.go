@@ -0,0 +1,77 @@
+package chaos
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestSafetyMonitor builds a monitor whose CPU limit is maxCPUPercent
+func newTestSafetyMonitor(t *testing.T, maxCPUPercent float64) *SafetyMonitor {
+	t.Helper()
+
+	config := NewDefaultConfig()
+	config.MaxCPUUsagePercent = maxCPUPercent
+	monitor, err := NewSafetyMonitor(config)
+	if err != nil {
+		t.Fatalf("NewSafetyMonitor() error = %v", err)
+	}
+	return monitor
+}
+
+// simulateCPUWindow backdates the last CPU sample by one window during which
+// the process kept busyShare of every core busy, so the next sample measures it
+func simulateCPUWindow(t *testing.T, rm *ResourceMonitor, busyShare float64) {
+	t.Helper()
+
+	cpuTime, ok := processCPUTime()
+	if !ok {
+		t.Skip("process CPU sampling is not supported on this platform")
+	}
+	busy := time.Duration(busyShare * float64(cpuSampleWindow) * float64(runtime.NumCPU()))
+	rm.lastCPUTime = cpuTime - busy
+	rm.lastCPUSample = time.Now().Add(-cpuSampleWindow)
+}
+
+func TestCPULimitRaisesResourceViolation(t *testing.T) {
+	monitor := newTestSafetyMonitor(t, 0.1)
+	simulateCPUWindow(t, monitor.resourceMonitor, 1.0)
+
+	err := monitor.IsOperationSafe("Installing dependencies")
+	if err == nil || !strings.Contains(err.Error(), "CPU usage exceeded") {
+		t.Fatalf("IsOperationSafe() error = %v, want a CPU limit violation", err)
+	}
+
+	violations := monitor.healthCheck.violations
+	if len(violations) != 1 || violations[0].Type != ResourceViolation || violations[0].Severity != Critical {
+		t.Errorf("violations = %+v, want one critical ResourceViolation", violations)
+	}
+	if got := monitor.GetSafetyStatus().ResourceUsage.CPUPercent; got < 90 {
+		t.Errorf("ResourceUsage.CPUPercent = %.1f, want the sampled usage near 100", got)
+	}
+}
+
+func TestCPUBelowLimitIsAllowed(t *testing.T) {
+	monitor := newTestSafetyMonitor(t, 50)
+	simulateCPUWindow(t, monitor.resourceMonitor, 0)
+
+	if err := monitor.IsOperationSafe("Installing dependencies"); err != nil {
+		t.Errorf("IsOperationSafe() error = %v, want an idle process allowed", err)
+	}
+	if got := monitor.GetSafetyStatus().ResourceUsage.CPUPercent; got >= 50 {
+		t.Errorf("ResourceUsage.CPUPercent = %.1f, want it below the limit", got)
+	}
+}
+
+func TestCPULimitIsSkippedWithoutSampling(t *testing.T) {
+	monitor := newTestSafetyMonitor(t, 0.1)
+	monitor.resourceMonitor.cpuSupported = false
+
+	if err := monitor.IsOperationSafe("Installing dependencies"); err != nil {
+		t.Errorf("IsOperationSafe() error = %v, want the CPU limit unenforced", err)
+	}
+	if got := monitor.GetSafetyStatus().ResourceUsage.CPUPercent; got != 0 {
+		t.Errorf("ResourceUsage.CPUPercent = %.1f, want 0 where sampling is unsupported", got)
+	}
+}
@@ -0,0 +1,85 @@
+package chaos
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// DefaultTelemetryPath is where injection events are written when no path is configured
+const DefaultTelemetryPath = ".engx/chaos-telemetry.jsonl"
+
+// TelemetrySink receives chaos injection events for the audit trail
+type TelemetrySink interface {
+	Record(event InjectionEvent)
+}
+
+// NoopTelemetrySink discards all events (used when telemetry is disabled)
+type NoopTelemetrySink struct{}
+
+// Record discards the event
+func (NoopTelemetrySink) Record(event InjectionEvent) {}
+
+// FileTelemetrySink appends events as JSON lines to a file
+type FileTelemetrySink struct {
+	path  string
+	mutex sync.Mutex
+}
+
+// NewFileTelemetrySink creates a sink writing JSONL events to the given path
+func NewFileTelemetrySink(path string) *FileTelemetrySink {
+	return &FileTelemetrySink{path: path}
+}
+
+// Record appends the event to the telemetry file.
+// Failures are reported on stderr so the audit trail never breaks a run.
+func (s *FileTelemetrySink) Record(event InjectionEvent) {
+	if err := s.write(event); err != nil {
+		fmt.Fprintf(os.Stderr, "[CHAOS AUDIT] failed to record telemetry: %v\n", err)
+	}
+}
+
+// write serializes the event and appends it to the file
+func (s *FileTelemetrySink) write(event InjectionEvent) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal injection event: %w", err)
+	}
+
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create telemetry directory: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open telemetry file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write telemetry event: %w", err)
+	}
+
+	return nil
+}
+
+// NewTelemetrySink selects the telemetry sink for the given configuration
+func NewTelemetrySink(config *ChaosConfig) TelemetrySink {
+	if config == nil || !config.TelemetryEnabled {
+		return NoopTelemetrySink{}
+	}
+
+	path := config.TelemetryPath
+	if path == "" {
+		path = DefaultTelemetryPath
+	}
+
+	return NewFileTelemetrySink(path)
+}
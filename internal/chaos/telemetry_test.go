@@ -0,0 +1,59 @@
+package chaos
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewTelemetrySinkSelection(t *testing.T) {
+	if _, ok := NewTelemetrySink(NewDefaultConfig()).(NoopTelemetrySink); !ok {
+		t.Error("NewTelemetrySink() with telemetry disabled is not a NoopTelemetrySink")
+	}
+
+	config := NewDefaultConfig()
+	config.TelemetryEnabled = true
+	if _, ok := NewTelemetrySink(config).(*FileTelemetrySink); !ok {
+		t.Error("NewTelemetrySink() with telemetry enabled is not a *FileTelemetrySink")
+	}
+}
+
+func TestEnabledTelemetryWritesStructuredEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "telemetry.jsonl")
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.TelemetryEnabled = true
+		c.TelemetryPath = path
+	})
+
+	for _, name := range []string{"network_failure", "permission_denied"} {
+		injector.InjectFailure("Installing dependencies", injector.scenarios[name])
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("telemetry file not written: %v", err)
+	}
+	defer file.Close()
+
+	var events []InjectionEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event InjectionEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("telemetry line %q is not a JSON InjectionEvent: %v", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("got %d telemetry events, want 2", len(events))
+	}
+	if events[0].Scenario != "network_failure" || events[1].Scenario != "permission_denied" {
+		t.Errorf("scenarios = %s, %s; want network_failure, permission_denied", events[0].Scenario, events[1].Scenario)
+	}
+	if events[0].Operation != "Installing dependencies" {
+		t.Errorf("operation = %q, want %q", events[0].Operation, "Installing dependencies")
+	}
+}
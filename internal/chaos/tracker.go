@@ -3,6 +3,7 @@ package chaos
 import (
 	"fmt"
 	"math/rand"
+	"os"
 	"sync"
 	"time"
 
@@ -25,6 +26,16 @@ type ChaosAwareTracker struct {
 	// State tracking
 	stepFailures     map[int]bool      // Track which steps have failed due to chaos
 	recoveryAttempts map[int]int       // Track recovery attempts per step
+	lastChaosFailure int               // Index of the most recent chaos-failed step (-1 if none)
+	injectingStep    int               // Step whose chaos scenario is mid-delay (-1 if none)
+	dryRunLog        []DryRunEvent     // Would-be injections recorded in dry-run mode
+	traceFile        string            // Append generated stack traces here when set
+	traceFileErr     error             // First failure writing traceFile
+
+	// Single RNG for enhanced failures and recovery rolls, seeded from the
+	// injector's RandomSeed so seeded runs are reproducible
+	random   *rand.Rand
+	randomMu sync.Mutex
 
 	// Thread safety
 	mutex sync.RWMutex
@@ -40,6 +51,11 @@ type StepExecutionResult struct {
 	ExecutionTime    time.Duration `json:"execution_time"`
 	ErrorMessage     string        `json:"error_message,omitempty"`
 	RecoveryRequired bool          `json:"recovery_required"`
+
+	// NaturalFailure is the base tracker's roll for the step. It only fails
+	// the step when no chaos was injected, but it is rolled for every step so
+	// the base RNG stays in step whatever chaos decides.
+	NaturalFailure bool `json:"natural_failure,omitempty"`
 }
 
 // NewChaosAwareTracker creates a new chaos-aware tracker wrapping an existing tracker
@@ -53,6 +69,17 @@ func NewChaosAwareTracker(baseTracker *progress.Tracker, injector ChaosInjector)
 		enabled:          injector != nil && injector.IsEnabled(),
 		stepFailures:     make(map[int]bool),
 		recoveryAttempts: make(map[int]int),
+		lastChaosFailure: -1,
+		injectingStep:    -1,
+		random:           newTrackerRandom(injector),
+	}
+
+	// Let the injector target scenarios by step tags
+	if injector != nil && baseTracker != nil {
+		for i := 0; i < baseTracker.TotalSteps(); i++ {
+			step := baseTracker.GetStep(i)
+			injector.RegisterOperationTags(step.Name, step.Tags)
+		}
 	}
 
 	// Start behavior tracking session
@@ -63,6 +90,24 @@ func NewChaosAwareTracker(baseTracker *progress.Tracker, injector ChaosInjector)
 	return tracker
 }
 
+// newTrackerRandom seeds the tracker's RNG from the injector's RandomSeed,
+// falling back to the clock when no seed is configured
+func newTrackerRandom(injector ChaosInjector) *rand.Rand {
+	if injector != nil {
+		if config := injector.GetConfig(); config != nil && config.RandomSeed != 0 {
+			return rand.New(rand.NewSource(config.RandomSeed))
+		}
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// randomFloat draws from the tracker's RNG, which is not safe for concurrent use
+func (cat *ChaosAwareTracker) randomFloat() float64 {
+	cat.randomMu.Lock()
+	defer cat.randomMu.Unlock()
+	return cat.random.Float64()
+}
+
 // ExecuteStep executes a step with potential chaos injection
 func (cat *ChaosAwareTracker) ExecuteStep(stepIndex int) *StepExecutionResult {
 	startTime := time.Now()
@@ -86,10 +131,18 @@ func (cat *ChaosAwareTracker) ExecuteStep(stepIndex int) *StepExecutionResult {
 		ExecutionTime: 0,
 	}
 
-	// Check if chaos should be injected for this step
-	if cat.enabled && cat.shouldInjectChaosForStep(step) {
+	// Roll the natural failure first, for every step, so the chaos seed and
+	// injection decisions never change which base rolls happen
+	result.NaturalFailure = cat.shouldStepFail(step)
+
+	// In dry-run mode only record what would have been injected
+	if cat.enabled && cat.isDryRun() {
+		cat.traceDryRun(stepIndex, step)
+	} else if cat.enabled && cat.shouldInjectChaosForStep(stepIndex, step) {
 		result.ChaosInjected = true
+		cat.setInjectingStep(stepIndex)
 		chaosResult := cat.executeChaosScenario(step)
+		cat.setInjectingStep(-1)
 
 		if chaosResult.Error != nil {
 			result.Success = false
@@ -100,20 +153,22 @@ func (cat *ChaosAwareTracker) ExecuteStep(stepIndex int) *StepExecutionResult {
 			// Mark this step as failed due to chaos
 			cat.mutex.Lock()
 			cat.stepFailures[stepIndex] = true
+			cat.lastChaosFailure = stepIndex
 			cat.mutex.Unlock()
 
 			// Record injection event
 			cat.recordInjectionEvent(stepIndex, step.Name, chaosResult)
-		}
-	} else {
-		// Execute normal step logic with existing error rate
-		if cat.shouldStepFail(step) {
-			result.Success = false
-			result.ErrorMessage = fmt.Sprintf("Step failed: %s", step.Name)
-			result.RecoveryRequired = step.CanRetry
+			cat.appendStackTrace(step.Name, chaosResult.ScenarioType)
 		}
 	}
 
+	// The natural roll only decides steps chaos left alone
+	if !result.ChaosInjected && result.NaturalFailure {
+		result.Success = false
+		result.ErrorMessage = fmt.Sprintf("Step failed: %s", step.Name)
+		result.RecoveryRequired = step.CanRetry
+	}
+
 	result.ExecutionTime = time.Since(startTime)
 
 	// Record user action for behavior analysis
@@ -133,15 +188,106 @@ func (cat *ChaosAwareTracker) ExecuteStep(stepIndex int) *StepExecutionResult {
 }
 
 // shouldInjectChaosForStep determines if chaos should be injected for a specific step
-func (cat *ChaosAwareTracker) shouldInjectChaosForStep(step *progress.Step) bool {
+func (cat *ChaosAwareTracker) shouldInjectChaosForStep(stepIndex int, step *progress.Step) bool {
 	if !cat.enabled || cat.chaosInjector == nil {
 		return false
 	}
 
+	// Steps outside the configured step list are never injected
+	if !cat.isStepEnabled(step) {
+		return false
+	}
+
+	// Cascade prevention: never inject into the step right after a chaos failure
+	if cat.isCascadeProtected(stepIndex) {
+		return false
+	}
+
 	// Use the step name as the operation identifier
 	return cat.chaosInjector.ShouldInject(step.Name)
 }
 
+// isStepEnabled checks the injector's step list for the step
+func (cat *ChaosAwareTracker) isStepEnabled(step *progress.Step) bool {
+	config := cat.chaosInjector.GetConfig()
+	return config == nil || config.IsStepEnabled(step.Name)
+}
+
+// isDryRun checks if the injector is configured for a chaos dry run
+func (cat *ChaosAwareTracker) isDryRun() bool {
+	if cat.chaosInjector == nil {
+		return false
+	}
+	config := cat.chaosInjector.GetConfig()
+	return config != nil && config.DryRun
+}
+
+// traceDryRun records a would-inject point without calling InjectFailure
+func (cat *ChaosAwareTracker) traceDryRun(stepIndex int, step *progress.Step) {
+	if !cat.isStepEnabled(step) || cat.isCascadeProtected(stepIndex) {
+		return
+	}
+
+	inject, reason := cat.chaosInjector.ShouldInjectTraced(step.Name)
+	if !inject {
+		return
+	}
+
+	event := DryRunEvent{
+		Timestamp: time.Now(),
+		StepIndex: stepIndex,
+		Operation: step.Name,
+		Scenario:  "enhanced_failure",
+		Reason:    reason,
+	}
+	if scenario := cat.chaosInjector.SelectScenario(step.Name); scenario != nil {
+		event.Scenario = scenario.ErrorScenario.Type
+	}
+
+	cat.mutex.Lock()
+	cat.dryRunLog = append(cat.dryRunLog, event)
+	cat.mutex.Unlock()
+}
+
+// GetDryRunLog returns the would-be injections recorded in dry-run mode
+func (cat *ChaosAwareTracker) GetDryRunLog() []DryRunEvent {
+	cat.mutex.RLock()
+	defer cat.mutex.RUnlock()
+
+	log := make([]DryRunEvent, len(cat.dryRunLog))
+	copy(log, cat.dryRunLog)
+	return log
+}
+
+// isCascadeProtected checks if the step immediately follows a chaos-failed step
+// while cascade prevention is enabled
+func (cat *ChaosAwareTracker) isCascadeProtected(stepIndex int) bool {
+	config := cat.chaosInjector.GetConfig()
+	if config == nil || !config.CascadePrevent {
+		return false
+	}
+
+	cat.mutex.RLock()
+	defer cat.mutex.RUnlock()
+
+	return cat.lastChaosFailure >= 0 && stepIndex == cat.lastChaosFailure+1
+}
+
+// InjectingStep reports the step whose chaos scenario is currently playing
+// out its simulated delay, so the UI can show it as stalling
+func (cat *ChaosAwareTracker) InjectingStep() (int, bool) {
+	cat.mutex.RLock()
+	defer cat.mutex.RUnlock()
+	return cat.injectingStep, cat.injectingStep >= 0
+}
+
+// setInjectingStep records which step is mid-injection (-1 clears it)
+func (cat *ChaosAwareTracker) setInjectingStep(stepIndex int) {
+	cat.mutex.Lock()
+	cat.injectingStep = stepIndex
+	cat.mutex.Unlock()
+}
+
 // executeChaosScenario executes a chaos scenario for the given step
 func (cat *ChaosAwareTracker) executeChaosScenario(step *progress.Step) *ChaosExecutionResult {
 	result := &ChaosExecutionResult{
@@ -162,9 +308,7 @@ func (cat *ChaosAwareTracker) executeChaosScenario(step *progress.Step) *ChaosEx
 	} else {
 		// No scenario found, but still inject a failure based on enhanced error rate
 		enhancedRate := cat.chaosInjector.CalculateEnhancedErrorRate(step.Name, step.ErrorRate)
-		random := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-		if random.Float64() < enhancedRate {
+		if cat.randomFloat() < enhancedRate {
 			result.Error = fmt.Errorf("CHAOS INJECTION: Enhanced failure for %s", step.Name)
 			result.Success = false
 			result.ScenarioType = "enhanced_failure"
@@ -179,12 +323,8 @@ func (cat *ChaosAwareTracker) executeChaosScenario(step *progress.Step) *ChaosEx
 
 // shouldStepFail determines if a step should fail based on its natural error rate
 func (cat *ChaosAwareTracker) shouldStepFail(step *progress.Step) bool {
-	if step.ErrorRate <= 0 {
-		return false
-	}
-
-	random := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return random.Float64() < step.ErrorRate
+	// Use the base tracker's RNG so chaos seeding never shifts base outcomes
+	return cat.Tracker.ShouldFail(step)
 }
 
 // recordInjectionEvent records a chaos injection event
@@ -250,6 +390,11 @@ func (cat *ChaosAwareTracker) AttemptStepRecovery(stepIndex int) (*RecoveryResul
 			result.Success = true // Always succeed with solution
 			result.Solution = cat.generateRecoverySolution(step, pattern)
 		}
+
+		// Scripted demos bypass the RNG and recover on a fixed attempt
+		if config := cat.chaosInjector.GetConfig(); config != nil && config.ForcedRecoveryAttempt > 0 {
+			result.Success = attempts >= config.ForcedRecoveryAttempt
+		}
 	} else {
 		// Basic recovery without chaos intelligence
 		result.Success = step.CanRetry
@@ -283,46 +428,76 @@ func (cat *ChaosAwareTracker) AttemptStepRecovery(stepIndex int) (*RecoveryResul
 
 // attemptBasicRecovery attempts basic recovery without assistance
 func (cat *ChaosAwareTracker) attemptBasicRecovery(step *progress.Step, pattern *BehaviorPattern) bool {
-	// Success rate depends on user skill level and step complexity
-	baseSuccessRate := 0.3 // 30% base success rate for unassisted recovery
-
-	if pattern != nil {
-		switch pattern.SkillLevel {
-		case Expert:
-			baseSuccessRate = 0.8
-		case Advanced:
-			baseSuccessRate = 0.6
-		case Intermediate:
-			baseSuccessRate = 0.4
-		case Novice:
-			baseSuccessRate = 0.2
-		}
-	}
+	// 30% base success rate for unassisted recovery when skill is unknown
+	baseSuccessRate := cat.recoverySuccessRate(MinimalAssistance, pattern, 0.3)
 
-	random := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return random.Float64() < baseSuccessRate
+	return cat.randomFloat() < baseSuccessRate
 }
 
 // attemptGuidedRecovery attempts recovery with hints
 func (cat *ChaosAwareTracker) attemptGuidedRecovery(step *progress.Step, pattern *BehaviorPattern) bool {
 	// Higher success rate with hints
-	baseSuccessRate := 0.7
-
-	if pattern != nil {
-		switch pattern.SkillLevel {
-		case Expert:
-			baseSuccessRate = 0.95
-		case Advanced:
-			baseSuccessRate = 0.85
-		case Intermediate:
-			baseSuccessRate = 0.75
-		case Novice:
-			baseSuccessRate = 0.6
+	baseSuccessRate := cat.recoverySuccessRate(HintProvided, pattern, 0.7)
+
+	return cat.randomFloat() < baseSuccessRate
+}
+
+// recoverySuccessRate looks up the configured success rate for the user's skill level
+func (cat *ChaosAwareTracker) recoverySuccessRate(assistance AssistanceLevel, pattern *BehaviorPattern, fallback float64) float64 {
+	if pattern == nil || cat.chaosInjector == nil {
+		return fallback
+	}
+
+	config := cat.chaosInjector.GetConfig()
+	if config == nil {
+		return fallback
+	}
+
+	if rate, exists := config.GetRecoverySuccessRate(assistance, pattern.SkillLevel); exists {
+		return rate
+	}
+
+	return fallback
+}
+
+// RequestHint reveals a recovery hint for a failed step on the user's request
+func (cat *ChaosAwareTracker) RequestHint(stepIndex int) (string, error) {
+	return cat.requestAssistance(stepIndex, "hint", cat.generateRecoveryHint)
+}
+
+// RequestSolution reveals the full recovery solution for a failed step on the user's request
+func (cat *ChaosAwareTracker) RequestSolution(stepIndex int) (string, error) {
+	return cat.requestAssistance(stepIndex, "solution", cat.generateRecoverySolution)
+}
+
+// requestAssistance records a help request and returns the generated assistance text
+func (cat *ChaosAwareTracker) requestAssistance(stepIndex int, kind string, generate func(*progress.Step, *BehaviorPattern) string) (string, error) {
+	step := cat.GetStep(stepIndex)
+	if step == nil {
+		return "", fmt.Errorf("invalid step index: %d", stepIndex)
+	}
+
+	pattern := cat.userBehavior.GetCurrentPattern()
+	text := generate(step, pattern)
+
+	// Record help request for behavior analysis
+	if cat.enabled {
+		action := UserAction{
+			Timestamp:  time.Now(),
+			ActionType: HelpRequest,
+			Command:    fmt.Sprintf("%s_%s", kind, step.Name),
+			Context:    "recovery",
+			Success:    true,
 		}
+		cat.userBehavior.RecordAction(action)
 	}
 
-	random := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return random.Float64() < baseSuccessRate
+	return text, nil
+}
+
+// GetUserBehavior returns the behavior tracker used for recovery analysis
+func (cat *ChaosAwareTracker) GetUserBehavior() *BehaviorTracker {
+	return cat.userBehavior
 }
 
 // generateRecoveryHint generates a helpful hint for step recovery
@@ -410,6 +585,45 @@ func (cat *ChaosAwareTracker) GenerateErrorTemplate(stepIndex int, result *StepE
 	return template
 }
 
+// SetTraceFile makes every chaos-failed step append its generated stack trace to path
+func (cat *ChaosAwareTracker) SetTraceFile(path string) {
+	cat.mutex.Lock()
+	defer cat.mutex.Unlock()
+	cat.traceFile = path
+}
+
+// GetTraceFileError returns the first error hit while writing the trace file
+func (cat *ChaosAwareTracker) GetTraceFileError() error {
+	cat.mutex.RLock()
+	defer cat.mutex.RUnlock()
+	return cat.traceFileErr
+}
+
+// appendStackTrace writes the step's generated stack trace to the trace file
+func (cat *ChaosAwareTracker) appendStackTrace(stepName, scenarioType string) {
+	cat.mutex.Lock()
+	defer cat.mutex.Unlock()
+
+	if cat.traceFile == "" {
+		return
+	}
+
+	entry := fmt.Sprintf("=== %s step=%q scenario=%s ===\n%s\n\n",
+		time.Now().Format(time.RFC3339), stepName, scenarioType,
+		cat.generateStepSpecificStackTrace(stepName, scenarioType))
+
+	file, err := os.OpenFile(cat.traceFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err == nil {
+		_, err = file.WriteString(entry)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if err != nil && cat.traceFileErr == nil {
+		cat.traceFileErr = fmt.Errorf("failed to write chaos trace file %s: %w", cat.traceFile, err)
+	}
+}
+
 // generateStepSpecificStackTrace creates a realistic stack trace for the specific step and error type
 func (cat *ChaosAwareTracker) generateStepSpecificStackTrace(stepName, scenarioType string) string {
 	switch stepName {
@@ -458,7 +672,8 @@ func (cat *ChaosAwareTracker) generateStepSpecificStackTrace(stepName, scenarioT
    Error: ` + scenarioType + ` error occurred in ` + stepName
 }
 
-// AdaptDifficulty adapts the chaos difficulty based on user behavior
+// AdaptDifficulty adapts the chaos difficulty based on user behavior, logging
+// each change for the AAR's difficulty curve
 func (cat *ChaosAwareTracker) AdaptDifficulty() {
 	if !cat.enabled || cat.chaosInjector == nil {
 		return
@@ -485,6 +700,7 @@ func (cat *ChaosAwareTracker) AdaptDifficulty() {
 		}
 
 		cat.adaptationLog = append(cat.adaptationLog, event)
+		cat.chaosInjector.SetAggressivenessLevel(newLevel)
 	}
 }
 
@@ -562,6 +778,8 @@ func (cat *ChaosAwareTracker) Reset() {
 	// Reset chaos state
 	cat.stepFailures = make(map[int]bool)
 	cat.recoveryAttempts = make(map[int]int)
+	cat.lastChaosFailure = -1
+	cat.dryRunLog = nil
 	cat.injectionHistory = make([]InjectionEvent, 0)
 	cat.adaptationLog = make([]AdaptationEvent, 0)
 
@@ -590,6 +808,15 @@ type ChaosExecutionResult struct {
 	Error        error         `json:"error,omitempty"`
 }
 
+// DryRunEvent represents an injection point that would have fired outside dry-run mode
+type DryRunEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	StepIndex int       `json:"step_index"`
+	Operation string    `json:"operation"`
+	Scenario  string    `json:"scenario"`
+	Reason    string    `json:"reason"`
+}
+
 // RecoveryResult represents the result of a recovery attempt
 type RecoveryResult struct {
 	StepIndex       int               `json:"step_index"`
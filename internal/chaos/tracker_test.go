@@ -0,0 +1,476 @@
+package chaos
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+)
+
+// runAllSteps executes every step of a fresh create tracker through chaos
+func runAllSteps(t *testing.T, injector *SafeChaosInjector) []*StepExecutionResult {
+	t.Helper()
+
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+	results := make([]*StepExecutionResult, tracker.TotalSteps())
+	for i := range results {
+		results[i] = tracker.ExecuteStep(i)
+	}
+	return results
+}
+
+func TestCascadePreventionSkipsStepAfterChaosFailure(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	results := runAllSteps(t, newTestInjector(t, func(c *ChaosConfig) {
+		c.CascadePrevent = true
+	}))
+
+	failures := 0
+	for i, result := range results {
+		if !result.ChaosInjected || result.Success {
+			continue
+		}
+		failures++
+		if i+1 < len(results) && results[i+1].ChaosInjected {
+			t.Errorf("step %d was chaos-injected right after chaos-failed step %d", i+1, i)
+		}
+	}
+	if failures == 0 {
+		t.Fatal("no step failed from chaos; the test did not exercise cascade prevention")
+	}
+}
+
+func TestCascadePreventionOffAllowsConsecutiveInjections(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	results := runAllSteps(t, newTestInjector(t, func(c *ChaosConfig) {
+		c.CascadePrevent = false
+	}))
+
+	for i, result := range results {
+		if !result.ChaosInjected {
+			t.Errorf("step %d was not injected with forced injection and cascade prevention off", i)
+		}
+	}
+}
+
+func TestChaosStepsConfineInjection(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	listed := map[string]bool{"Installing dependencies": true, "Setting up environment": true}
+	steps := []string{"installing dependencies", "Setting up environment"}
+
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), newTestInjector(t, func(c *ChaosConfig) {
+		c.CascadePrevent = false
+		c.Steps = steps
+	}))
+	for i := 0; i < tracker.TotalSteps(); i++ {
+		name := tracker.GetStep(i).Name
+		result := tracker.ExecuteStep(i)
+		if result.ChaosInjected != listed[name] {
+			t.Errorf("step %q: ChaosInjected = %t, want %t", name, result.ChaosInjected, listed[name])
+		}
+	}
+
+	// Dry runs only report would-be injections for the listed steps too
+	dryRun := NewChaosAwareTracker(progress.NewCreateTracker(false), newTestInjector(t, func(c *ChaosConfig) {
+		c.DryRun = true
+		c.CascadePrevent = false
+		c.Steps = steps
+	}))
+	for i := 0; i < dryRun.TotalSteps(); i++ {
+		dryRun.ExecuteStep(i)
+	}
+	log := dryRun.GetDryRunLog()
+	if len(log) == 0 {
+		t.Fatal("GetDryRunLog() is empty, want would-be injections on the listed steps")
+	}
+	for _, event := range log {
+		if !listed[event.Operation] {
+			t.Errorf("dry run logged an injection for unlisted step %q", event.Operation)
+		}
+	}
+}
+
+func TestDryRunLogsWouldBeInjectionsWithoutFailing(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.DryRun = true
+	})
+	// Natural failures still happen in a dry run; zero them so only chaos could fail a step
+	base := progress.NewCreateTracker(false)
+	for i := 0; i < base.TotalSteps(); i++ {
+		base.GetStep(i).ErrorRate = 0
+	}
+	tracker := NewChaosAwareTracker(base, injector)
+
+	for i := 0; i < tracker.TotalSteps(); i++ {
+		result := tracker.ExecuteStep(i)
+		if !result.Success || result.ChaosInjected {
+			t.Errorf("step %d: Success=%t ChaosInjected=%t, want a clean step in dry-run", i, result.Success, result.ChaosInjected)
+		}
+	}
+
+	log := tracker.GetDryRunLog()
+	if len(log) == 0 {
+		t.Fatal("GetDryRunLog() is empty, want would-be injections")
+	}
+	for _, event := range log {
+		if event.Operation == "" || event.Scenario == "" || event.Reason == "" {
+			t.Errorf("dry-run event %+v is missing operation, scenario or reason", event)
+		}
+	}
+	if injector.metrics.TotalInjections != 0 {
+		t.Errorf("injector recorded %d injections in dry-run, want 0", injector.metrics.TotalInjections)
+	}
+}
+
+// uniformRecoveryRates sets every assistance and skill level to the same success rate
+func uniformRecoveryRates(rate float64) map[AssistanceLevel]map[SkillLevel]float64 {
+	rates := DefaultRecoverySuccessRates()
+	for _, bySkill := range rates {
+		for skill := range bySkill {
+			bySkill[skill] = rate
+		}
+	}
+	return rates
+}
+
+func TestRecoverySuccessRatesOverrideOutcomes(t *testing.T) {
+	for _, tt := range []struct {
+		rate        float64
+		wantSuccess bool
+	}{
+		{rate: 1.0, wantSuccess: true},
+		{rate: 0.0, wantSuccess: false},
+	} {
+		injector := newTestInjector(t, func(c *ChaosConfig) {
+			c.AggressivenessLevel = Off
+			c.RecoverySuccessRates = uniformRecoveryRates(tt.rate)
+		})
+		tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+		tracker.ExecuteStep(0)
+		tracker.stepFailures[0] = true
+
+		for attempt := 1; attempt <= 2; attempt++ {
+			result, err := tracker.AttemptStepRecovery(0)
+			if err != nil {
+				t.Fatalf("rate %.1f attempt %d: AttemptStepRecovery() error = %v", tt.rate, attempt, err)
+			}
+			if result.Success != tt.wantSuccess {
+				t.Errorf("rate %.1f attempt %d: Success = %t, want %t", tt.rate, attempt, result.Success, tt.wantSuccess)
+			}
+			if result.Success {
+				break
+			}
+		}
+	}
+}
+
+func TestRequestHintRecordsHelpRequest(t *testing.T) {
+	injector := newTestInjector(t, func(c *ChaosConfig) {
+		c.AggressivenessLevel = Off
+	})
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+
+	hint, err := tracker.RequestHint(0)
+	if err != nil {
+		t.Fatalf("RequestHint() error = %v", err)
+	}
+	if hint == "" {
+		t.Error("RequestHint() returned an empty hint")
+	}
+
+	actions := tracker.GetUserBehavior().getRecentActions(10)
+	if len(actions) != 1 || actions[0].ActionType != HelpRequest {
+		t.Fatalf("recorded actions = %+v, want one HelpRequest", actions)
+	}
+
+	if _, err := tracker.RequestHint(99); err == nil {
+		t.Error("RequestHint() for an invalid step returned no error")
+	}
+}
+
+func TestChaosSeedLeavesBaseOutcomesUnchanged(t *testing.T) {
+	// run executes every step on a base tracker seeded with 7 and returns
+	// the step results; only the chaos seed varies between runs
+	run := func(chaosSeed int64) []*StepExecutionResult {
+		base := progress.NewCreateTracker(false)
+		base.SetSeed(7)
+		for i := 0; i < base.TotalSteps(); i++ {
+			base.GetStep(i).ErrorRate = 0.5
+		}
+
+		injector := newTestInjector(t, func(c *ChaosConfig) {
+			c.AggressivenessLevel = Apocalyptic
+			c.RandomSeed = chaosSeed
+			c.CascadePrevent = false
+		})
+		tracker := NewChaosAwareTracker(base, injector)
+
+		results := make([]*StepExecutionResult, base.TotalSteps())
+		for i := range results {
+			results[i] = tracker.ExecuteStep(i)
+		}
+		return results
+	}
+
+	reference := run(1)
+	injectionsDiffer := false
+	for chaosSeed := int64(2); chaosSeed <= 10; chaosSeed++ {
+		results := run(chaosSeed)
+		for i, result := range results {
+			want := reference[i]
+			if result.ChaosInjected != want.ChaosInjected {
+				injectionsDiffer = true
+			}
+			if result.ChaosInjected || want.ChaosInjected {
+				continue
+			}
+			if result.Success != want.Success {
+				t.Errorf("chaos seed %d: step %d success = %t, want %t as with chaos seed 1", chaosSeed, i, result.Success, want.Success)
+			}
+		}
+	}
+	if !injectionsDiffer {
+		t.Fatal("every chaos seed injected the same steps; the test did not vary chaos")
+	}
+}
+
+// recoveringInjector injects chaos into every step, but every injected
+// scenario resolves without an error
+type recoveringInjector struct {
+	*SafeChaosInjector
+}
+
+func (r *recoveringInjector) ShouldInject(string) bool { return true }
+
+func (r *recoveringInjector) SelectScenario(string) *ChaosScenario {
+	return &ChaosScenario{ErrorScenario: &ErrorScenario{Type: "network_failure"}}
+}
+
+func (r *recoveringInjector) InjectFailure(string, *ChaosScenario) error { return nil }
+
+func TestNaturalFailureDoesNotFailChaosInjectedStep(t *testing.T) {
+	base := progress.NewCreateTracker(false)
+	base.SetSeed(7)
+	base.GetStep(0).ErrorRate = 1.0
+
+	injector := &recoveringInjector{SafeChaosInjector: newTestInjector(t, func(c *ChaosConfig) {
+		c.CascadePrevent = false
+	})}
+	tracker := NewChaosAwareTracker(base, injector)
+
+	result := tracker.ExecuteStep(0)
+	if !result.ChaosInjected || !result.NaturalFailure {
+		t.Fatalf("ExecuteStep() = %+v, want chaos injected and a natural failure rolled", result)
+	}
+	if !result.Success || result.ErrorMessage != "" {
+		t.Errorf("ExecuteStep() Success=%t ErrorMessage=%q, want the step to pass when chaos injected no error", result.Success, result.ErrorMessage)
+	}
+	if tracker.stepFailures[0] || tracker.lastChaosFailure != -1 {
+		t.Error("step recorded as a chaos failure although chaos injected no error")
+	}
+}
+
+func TestTraceFileRecordsNetworkFailureOnDependencies(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	injector := newTestInjector(t, nil)
+	for scenarioType := range injector.scenarios {
+		if scenarioType != "network_failure" {
+			delete(injector.scenarios, scenarioType)
+		}
+	}
+
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+	path := filepath.Join(t.TempDir(), "traces.log")
+	tracker.SetTraceFile(path)
+
+	index := -1
+	for i, step := range tracker.GetSteps() {
+		if step.Name == "Installing dependencies" {
+			index = i
+		}
+	}
+	if index < 0 {
+		t.Fatal("create tracker has no \"Installing dependencies\" step")
+	}
+
+	result := tracker.ExecuteStep(index)
+	if !result.ChaosInjected || result.Success {
+		t.Fatalf("ExecuteStep() = %+v, want a chaos-failed step", result)
+	}
+	if err := tracker.GetTraceFileError(); err != nil {
+		t.Fatalf("GetTraceFileError() = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading trace file: %v", err)
+	}
+	trace := string(data)
+	for _, want := range []string{`step="Installing dependencies"`, "scenario=network_failure", "ENOTFOUND registry.npmjs.org"} {
+		if !strings.Contains(trace, want) {
+			t.Errorf("trace file is missing %q:\n%s", want, trace)
+		}
+	}
+}
+
+func TestInjectingStepCoversScenarioDelay(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	injector := newTestInjector(t, nil)
+	for _, scenario := range injector.scenarios {
+		scenario.MinDuration = 200 * time.Millisecond
+		scenario.MaxDuration = 200 * time.Millisecond
+	}
+	tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+
+	if _, injecting := tracker.InjectingStep(); injecting {
+		t.Fatal("InjectingStep() reports an injection before any step ran")
+	}
+
+	done := make(chan *StepExecutionResult, 1)
+	go func() { done <- tracker.ExecuteStep(0) }()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if step, injecting := tracker.InjectingStep(); injecting {
+			if step != 0 {
+				t.Errorf("InjectingStep() = %d mid-injection, want 0", step)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("InjectingStep() never reported the delayed step")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if result := <-done; !result.ChaosInjected {
+		t.Fatal("forced injection did not inject chaos")
+	}
+	if step, injecting := tracker.InjectingStep(); injecting {
+		t.Errorf("InjectingStep() = %d after the injection resolved, want none", step)
+	}
+}
+
+func TestForcedRecoveryAttemptIsDeterministic(t *testing.T) {
+	for _, rate := range []float64{0.0, 1.0} {
+		injector := newTestInjector(t, func(c *ChaosConfig) {
+			c.AggressivenessLevel = Off
+			c.RecoverySuccessRates = uniformRecoveryRates(rate)
+			c.ForcedRecoveryAttempt = 4
+		})
+		tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), injector)
+		tracker.ExecuteStep(0)
+		tracker.stepFailures[0] = true
+
+		// Attempt 3 normally always succeeds with the full solution
+		for attempt := 1; attempt <= 4; attempt++ {
+			result, err := tracker.AttemptStepRecovery(0)
+			if err != nil {
+				t.Fatalf("rate %.1f attempt %d: AttemptStepRecovery() error = %v", rate, attempt, err)
+			}
+			if want := attempt == 4; result.Success != want {
+				t.Errorf("rate %.1f attempt %d: Success = %t, want %t", rate, attempt, result.Success, want)
+			}
+		}
+	}
+}
+
+func TestForcedRecoveryAttemptValidation(t *testing.T) {
+	config := NewDefaultConfig()
+	config.ForcedRecoveryAttempt = -1
+	if err := config.Validate(); err == nil {
+		t.Error("Validate() error = nil for a negative forced_recovery_attempt")
+	}
+}
+
+func TestSameSeedReplaysFailuresAndRecoveries(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	// run records each step's outcome and, for chaos failures, the first two
+	// recovery rolls, which are the attempts that draw from the RNG. Chaos is
+	// confined to the even steps; the odd ones roll their natural error rate
+	// against the seeded base tracker, as a --seed run does.
+	run := func(seed int64) []string {
+		base := progress.NewCreateTracker(false)
+		base.SetSeed(seed)
+		var chaosSteps []string
+		for i := 0; i < base.TotalSteps(); i++ {
+			if i%2 == 0 {
+				chaosSteps = append(chaosSteps, base.GetStep(i).Name)
+			} else {
+				base.GetStep(i).ErrorRate = 0.5
+			}
+		}
+
+		injector := newTestInjector(t, func(c *ChaosConfig) {
+			c.RandomSeed = seed
+			c.CascadePrevent = false
+			c.RecoverySuccessRates = uniformRecoveryRates(0.5)
+			c.Steps = chaosSteps
+		})
+		tracker := NewChaosAwareTracker(base, injector)
+
+		var outcomes []string
+		for i := 0; i < tracker.TotalSteps(); i++ {
+			result := tracker.ExecuteStep(i)
+			outcomes = append(outcomes, fmt.Sprintf("step %d: success=%t scenario=%q", i, result.Success, result.InjectedScenario))
+			if result.Success {
+				continue
+			}
+			for attempt := 1; attempt <= 2; attempt++ {
+				recovery, err := tracker.AttemptStepRecovery(i)
+				if err != nil {
+					break
+				}
+				outcomes = append(outcomes, fmt.Sprintf("step %d recovery %d: %t", i, attempt, recovery.Success))
+				if recovery.Success {
+					break
+				}
+			}
+		}
+		return outcomes
+	}
+
+	first, second := run(42), run(42)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("same seed produced different runs:\n%s\nvs\n%s", strings.Join(first, "\n"), strings.Join(second, "\n"))
+	}
+	if !strings.Contains(strings.Join(first, "\n"), "recovery") {
+		t.Fatal("no step needed recovery; the test did not exercise the recovery rolls")
+	}
+	if !strings.Contains(strings.Join(first, "\n"), `success=false scenario=""`) {
+		t.Fatal("no step failed naturally; the test did not exercise the base tracker's rolls")
+	}
+}
+
+func TestTrackerRandomFollowsInjectorSeed(t *testing.T) {
+	draws := func(seed int64) []float64 {
+		tracker := NewChaosAwareTracker(progress.NewCreateTracker(false), newTestInjector(t, func(c *ChaosConfig) {
+			c.RandomSeed = seed
+		}))
+		values := make([]float64, 5)
+		for i := range values {
+			values[i] = tracker.randomFloat()
+		}
+		return values
+	}
+
+	if first, second := draws(7), draws(7); !reflect.DeepEqual(first, second) {
+		t.Errorf("seed 7 drew %v then %v, want identical sequences", first, second)
+	}
+	if first, other := draws(7), draws(8); reflect.DeepEqual(first, other) {
+		t.Errorf("seeds 7 and 8 drew the same sequence %v", first)
+	}
+}
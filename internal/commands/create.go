@@ -2,24 +2,68 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/components"
 	"github.com/bthompso/engx-ergonomics-poc/internal/tui/models"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
 	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
 	"github.com/bthompso/engx-ergonomics-poc/internal/config"
 	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	progresssim "github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
+// chaosMetricsDir is where chaos runs export their metrics for the next
+// run's difficulty preview
+var chaosMetricsDir = filepath.Join(".engx", "chaos-metrics")
+
 // NewCreateCommand creates the 'create' command
 func NewCreateCommand() *cobra.Command {
 	var devOnly bool
 	var template string
 	var chaosMarine bool
 	var chaosLevel string
+	var seed int64
 	var chaosSeed int64
 	var chaosConfig string
+	var chaosDryRun bool
+	var chaosOffAfter time.Duration
+	var chaosRecoveryAttempt int
+	var chaosTraceFile string
+	var chaosSteps string
+	var renderFile string
+	var renderFilePlain bool
+	var reportFormat string
+	var reportDir string
+	var summaryOnly bool
+	var aarFlag string
+	var progressOnly bool
+	var minWidth int
+	var forcedWidth int
+	var maxStepsDisplay int
+	var componentSuccessRate float64
+	var componentWindowsPath string
+	var planDot bool
+	var listPhases bool
+	var noSubsteps bool
+	var separator string
+	var themeName string
+	var browseCommands bool
+	var interactiveComplete bool
+	var compactAAR bool
+	var keepGoing bool
+	var failFast bool
+	var useDefaults bool
+	var promptTimeout time.Duration
+	var promptTimeoutAction string
+	var strict bool
 
 	cmd := &cobra.Command{
 		Use:   "create [APP_NAME]",
@@ -39,7 +83,11 @@ Examples:
   engx create MyApp --template=typescript
   engx create MyApp --verbose
   engx create MyApp --chaos-marine --chaos-level=scout
-  engx create MyApp --chaos-marine --chaos-level=aggressive --chaos-seed=12345`,
+  engx create MyApp --chaos-marine --chaos-level=aggressive --chaos-seed=12345
+  engx create MyApp --seed=42 --chaos-marine --chaos-seed=7
+  engx create MyApp --chaos-marine --chaos-level=scout --chaos-dry-run
+  engx create MyApp --chaos-marine --chaos-steps="Installing dependencies,Setting up environment"
+  engx create MyApp --defaults --strict`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			appName := args[0]
@@ -52,9 +100,127 @@ Examples:
 
 			verbosityLevel := config.DetermineVerbosityLevel(quiet, concise, verbose, debug)
 			verbosityConfig := config.NewVerbosityConfig(verbosityLevel)
+			if noSubsteps {
+				verbosityConfig.ShowSubSteps = false
+			}
 
 			// Debug output for verbosity level determination
 			verbosityConfig.DebugPrint("Verbosity level determined: %s", verbosityLevel.String())
+			if warning, conflict := config.VerbosityEnvConflict(quiet, concise, verbose, debug); conflict {
+				verbosityConfig.DebugPrint("%s", warning)
+			}
+
+			if componentSuccessRate < 0 {
+				return fmt.Errorf("--component-success-rate must be >= 0, got %g", componentSuccessRate)
+			}
+
+			aarDisplay, err := resolveAARDisplay(aarFlag, summaryOnly)
+			if err != nil {
+				return err
+			}
+			summaryOnly = aarDisplay == aar.DisplayOnly
+
+			if forcedWidth < 0 {
+				return fmt.Errorf("--width must be >= 0, got %d", forcedWidth)
+			}
+
+			if maxStepsDisplay < 0 {
+				return fmt.Errorf("--max-steps-display must be >= 0, got %d", maxStepsDisplay)
+			}
+
+			if promptTimeout < 0 {
+				return fmt.Errorf("--prompt-timeout must be >= 0, got %s", promptTimeout)
+			}
+			timeoutAction, err := prompts.ParseTimeoutAction(promptTimeoutAction)
+			if err != nil {
+				return err
+			}
+
+			var reportFormats []aar.ReportFormat
+			if reportFormat != "" {
+				reportFormats, err = aar.ParseReportFormats(reportFormat)
+				if err != nil {
+					return fmt.Errorf("invalid --report-format: %w", err)
+				}
+			}
+
+			separatorRune, err := styles.ParseSeparator(separator)
+			if err != nil {
+				return fmt.Errorf("invalid --separator: %w", err)
+			}
+
+			theme, err := styles.ParseTheme(themeName)
+			if err != nil {
+				return fmt.Errorf("invalid --theme: %w", err)
+			}
+
+			var componentWindows map[string]components.ComponentWindow
+			if componentWindowsPath != "" {
+				componentWindows, err = components.LoadComponentWindows(componentWindowsPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Resolve color capability once for all renderers
+			colorFlag, _ := cmd.Flags().GetString("color")
+			colorMode, err := styles.ParseColorMode(colorFlag)
+			if err != nil {
+				return err
+			}
+			colorEnabled := styles.ColorEnabled(colorMode, os.Stdout)
+			styles.ApplyColorMode(colorEnabled)
+			verbosityConfig.DebugPrint("Color output: mode=%s enabled=%t", colorMode.String(), colorEnabled)
+
+			glyphFlag, _ := cmd.Flags().GetString("glyphs")
+			glyphMode, err := styles.ParseGlyphMode(glyphFlag)
+			if err != nil {
+				return err
+			}
+			styles.ApplyGlyphMode(glyphMode)
+
+			outputFlag, _ := cmd.Flags().GetString("output")
+			outputMode, err := models.ParseOutputMode(outputFlag)
+			if err != nil {
+				return err
+			}
+			jsonOutput := outputMode == models.OutputJSON
+
+			// Fail early and uniformly on a bad --config or --chaos-config file
+			if configPath, _ := cmd.Flags().GetString("config"); configPath != "" {
+				absPath, err := config.CheckConfigFile(configPath)
+				if err != nil {
+					return err
+				}
+				verbosityConfig.DebugPrint("Using config file: %s", absPath)
+			}
+			if chaosConfig != "" {
+				absPath, err := config.CheckFile(chaosConfig, chaos.ParseConfigData)
+				if err != nil {
+					return err
+				}
+				verbosityConfig.DebugPrint("Using chaos config file: %s", absPath)
+			}
+
+			// Emit the resolved step plan as a DOT graph and exit
+			if planDot {
+				fmt.Fprint(cmd.OutOrStdout(), renderCreatePlanDOT(devOnly))
+				return nil
+			}
+
+			// Explain which steps drive which component phases and exit
+			if listPhases {
+				fmt.Fprint(cmd.OutOrStdout(), renderCreatePhaseList(devOnly))
+				return nil
+			}
+
+			// --fail-fast=false asks for the same behavior as --keep-going
+			keepGoing = keepGoing || !failFast
+
+			// Chaos follows the base seed unless given its own
+			if !cmd.Flags().Changed("chaos-seed") {
+				chaosSeed = seed
+			}
 
 			// Initialize chaos configuration if chaos marine is enabled
 			var chaosInjector chaos.ChaosInjector
@@ -64,12 +230,39 @@ Examples:
 					return fmt.Errorf("failed to load chaos configuration: %w", err)
 				}
 
-				chaosInjector, err = chaos.NewSafeChaosInjector(chaosConfig)
+				chaosConfig.DryRun = chaosDryRun
+				if cmd.Flags().Changed("chaos-off-after") {
+					if chaosOffAfter < 0 {
+						return fmt.Errorf("--chaos-off-after must be >= 0, got %s", chaosOffAfter)
+					}
+					chaosConfig.OffAfter = chaosOffAfter
+				}
+				if cmd.Flags().Changed("chaos-recovery-attempt") {
+					if chaosRecoveryAttempt < 0 {
+						return fmt.Errorf("--chaos-recovery-attempt must be >= 0, got %d", chaosRecoveryAttempt)
+					}
+					chaosConfig.ForcedRecoveryAttempt = chaosRecoveryAttempt
+				}
+				if cmd.Flags().Changed("chaos-steps") {
+					steps := chaos.ParseStepList(chaosSteps)
+					if len(steps) == 0 {
+						return fmt.Errorf("--chaos-steps must name at least one step, got %q", chaosSteps)
+					}
+					chaosConfig.Steps = steps
+				}
+
+				safeInjector, err := chaos.NewSafeChaosInjector(chaosConfig)
 				if err != nil {
 					return fmt.Errorf("failed to initialize chaos injector: %w", err)
 				}
+				chaosInjector = safeInjector
 
 				verbosityConfig.DebugPrint("Chaos Marine enabled: level=%s, seed=%d", chaosLevel, chaosSeed)
+
+				// Set expectations for the difficulty the learner will likely experience
+				if !verbosityConfig.IsQuiet() && !progressOnly && !jsonOutput {
+					fmt.Fprintln(cmd.OutOrStdout(), chaos.PreviewDifficulty(chaosInjector, loadPriorBehavior()).String())
+				}
 			}
 
 			// Collect only explicitly set flags for display purposes
@@ -80,18 +273,42 @@ Examples:
 			if cmd.Flags().Changed("template") && template != "" {
 				flags = append(flags, fmt.Sprintf("--template=%s", template))
 			}
+			if cmd.Flags().Changed("defaults") && useDefaults {
+				flags = append(flags, "--defaults")
+			}
+			if cmd.Flags().Changed("strict") && strict {
+				flags = append(flags, "--strict")
+			}
+			if keepGoing {
+				flags = append(flags, "--keep-going")
+			}
 			if cmd.Flags().Changed("chaos-marine") && chaosMarine {
 				flags = append(flags, "--chaos-marine")
 			}
 			if cmd.Flags().Changed("chaos-level") && chaosLevel != "" {
 				flags = append(flags, fmt.Sprintf("--chaos-level=%s", chaosLevel))
 			}
+			if cmd.Flags().Changed("seed") && seed != 0 {
+				flags = append(flags, fmt.Sprintf("--seed=%d", seed))
+			}
 			if cmd.Flags().Changed("chaos-seed") && chaosSeed != 0 {
 				flags = append(flags, fmt.Sprintf("--chaos-seed=%d", chaosSeed))
 			}
 			if cmd.Flags().Changed("chaos-config") && chaosConfig != "" {
 				flags = append(flags, fmt.Sprintf("--chaos-config=%s", chaosConfig))
 			}
+			if cmd.Flags().Changed("chaos-dry-run") && chaosDryRun {
+				flags = append(flags, "--chaos-dry-run")
+			}
+			if cmd.Flags().Changed("chaos-off-after") && chaosOffAfter > 0 {
+				flags = append(flags, fmt.Sprintf("--chaos-off-after=%s", chaosOffAfter))
+			}
+			if cmd.Flags().Changed("chaos-recovery-attempt") && chaosRecoveryAttempt > 0 {
+				flags = append(flags, fmt.Sprintf("--chaos-recovery-attempt=%d", chaosRecoveryAttempt))
+			}
+			if cmd.Flags().Changed("chaos-steps") && chaosSteps != "" {
+				flags = append(flags, fmt.Sprintf("--chaos-steps=%s", chaosSteps))
+			}
 
 			// Add verbosity flags to display
 			if quiet {
@@ -108,19 +325,41 @@ Examples:
 			}
 
 			// Run inline prompts first (traditional CLI style)
-			prompter, err := prompts.NewInlinePrompter()
-			if err != nil {
-				return fmt.Errorf("failed to initialize prompter: %w", err)
-			}
+			stdinIsTerminal := term.IsTerminal(int(os.Stdin.Fd()))
+			var userConfig *config.UserConfiguration
+			// Progress-only and JSON stdout carry nothing but NDJSON, so prompts can't share it
+			if useDefaults || progressOnly || jsonOutput {
+				userConfig = prompts.DefaultUserConfiguration()
+			} else {
+				prompter, err := prompts.NewInlinePrompter()
+				if err != nil {
+					return fmt.Errorf("failed to initialize prompter: %w", err)
+				}
+				prompter.SetColorEnabled(colorEnabled)
+				prompter.SetTimeout(promptTimeout, timeoutAction)
 
-			userConfig, err := prompter.RunPrompts(devOnly, flags)
-			if err != nil {
-				return fmt.Errorf("failed to run prompts: %w", err)
+				// Piped stdin can't answer forever; fall back to defaults rather than fail
+				if !stdinIsTerminal {
+					verbosityConfig.DebugPrint("stdin is not a terminal; missing answers will use defaults")
+					prompter.SetNonInteractive(true)
+				}
+
+				userConfig, err = prompter.RunPrompts(devOnly, flags)
+				if err != nil {
+					return fmt.Errorf("failed to run prompts: %w", err)
+				}
 			}
 
 			// Set the project name in config
 			userConfig.ProjectName = appName
 
+			// Strict mode gates CI on a clean configuration before anything runs
+			if strict {
+				if err := config.ValidateConfigurationStrict(*userConfig); err != nil {
+					return err
+				}
+			}
+
 			// Initialize and run TUI with configuration already set (inline mode)
 			var model *models.AppModel
 			if chaosInjector != nil {
@@ -128,25 +367,150 @@ Examples:
 			} else {
 				model = models.NewAppModelWithVerbosity("create", appName, flags, userConfig, verbosityConfig)
 			}
+			model.SetColorEnabled(colorEnabled)
+			model.SetSeed(seed)
+			model.SetMinWidth(minWidth)
+			model.SetForcedWidth(forcedWidth)
+			model.SetMaxStepsDisplay(maxStepsDisplay)
+			model.SetSeparator(separatorRune)
+			model.SetTheme(theme)
+			model.SetBrowseCommands(browseCommands)
+			model.SetInteractiveComplete(interactiveComplete)
+			model.SetCompactAAR(compactAAR)
+			model.SetAARDisplay(aarDisplay)
+			model.SetKeepGoing(keepGoing)
+			model.SetChaosTraceFile(chaosTraceFile)
+			if cmd.Flags().Changed("component-success-rate") {
+				model.SetComponentSuccessRateFactor(componentSuccessRate)
+			}
+			if componentWindows != nil {
+				model.SetComponentWindows(componentWindows)
+			}
+
+			// Time the chaos window from execution, not from prompting
+			if safeInjector, ok := chaosInjector.(*chaos.SafeChaosInjector); ok {
+				safeInjector.MarkStart()
+			}
+
+			// Record what this run was asked to do before it starts
+			manifest := &RunManifest{
+				StartedAt: time.Now(),
+				Command:   "create",
+				AppName:   appName,
+				Flags:     flags,
+				Seed:      seed,
+				Chaos: ManifestChaos{
+					Enabled: chaosMarine,
+					Level:   chaosLevel,
+					Seed:    chaosSeed,
+					Config:  chaosConfig,
+					DryRun:  chaosDryRun,
+
+					RecoveryAttempt: chaosRecoveryAttempt,
+				},
+				Config: userConfig,
+			}
+			if chaosOffAfter > 0 {
+				manifest.Chaos.OffAfter = chaosOffAfter.String()
+			}
+			if chaosMarine && cmd.Flags().Changed("chaos-steps") {
+				manifest.Chaos.Steps = chaos.ParseStepList(chaosSteps)
+			}
+			if err := writeRunManifest(manifest); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+
+			// Surface the seeds up front so the run can be reproduced from a bug report
+			printReproBanner(cmd.OutOrStdout(), manifest, verbosityConfig.IsQuiet() || progressOnly || jsonOutput)
+
+			// finishRun stamps the outcome on the manifest, saves chaos metrics,
+			// writes the requested reports and warns about trace file trouble.
+			// Keep-going runs with failed steps still return an error so the
+			// exit code is non-zero.
+			finishRun := func(model *models.AppModel) error {
+				outcome := model.GetRunOutcome()
+				if err := finishRunManifest(manifest, outcome); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				}
+				exportChaosMetrics(model)
+				writeReports(model, reportDir, reportFormats)
+				if traceErr := model.GetChaosTraceFileError(); traceErr != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %v\n", traceErr)
+				}
+				if outcome.Status == "completed_with_errors" {
+					return fmt.Errorf("%s (--keep-going)", outcome.Error)
+				}
+				return nil
+			}
+
+			// Progress-only and JSON modes: stream NDJSON and nothing else
+			if progressOnly || jsonOutput {
+				var err error
+				if jsonOutput {
+					err = model.RunJSONEvents(cmd.OutOrStdout(), models.DefaultProgressInterval)
+				} else {
+					err = model.RunProgressOnly(cmd.OutOrStdout(), models.DefaultProgressInterval)
+				}
+				if finishErr := finishRun(model); err == nil {
+					err = finishErr
+				}
+				return err
+			}
+
+			// Headless mode: skip the animation and print only the AAR
+			if summaryOnly {
+				output, err := model.RunHeadless()
+				fmt.Fprint(cmd.OutOrStdout(), output)
+				if finishErr := finishRun(model); err == nil {
+					err = finishErr
+				}
+				return err
+			}
 
-			// Configure for inline mode with proper input/output handling
+			// Configure for inline mode with proper input/output handling;
+			// non-terminal stdin can't deliver keys, so don't read it at all
+			var input io.Reader = os.Stdin
+			if !stdinIsTerminal {
+				input = nil
+			}
 			program := tea.NewProgram(
 				model,
-				tea.WithInput(os.Stdin),
+				tea.WithInput(input),
 				tea.WithOutput(os.Stderr),
 			)
 
+			defer model.StopResizeWatch()
+
 			finalModel, err := program.Run()
 			if err != nil {
 				return fmt.Errorf("failed to run application: %w", err)
 			}
 
+			appModel, ok := finalModel.(*models.AppModel)
+			if !ok {
+				return nil
+			}
+
 			// Print AAR after TUI exits if available
-			if appModel, ok := finalModel.(*models.AppModel); ok && appModel.GetAAROutput() != "" {
-				fmt.Print(appModel.GetAAROutput())
+			if output := appModel.GetAAROutput(); output != "" {
+				fmt.Fprint(cmd.OutOrStdout(), output)
 			}
 
-			return nil
+			// Report would-be injections for chaos dry runs
+			if chaosMarine && chaosDryRun {
+				printChaosDryRunLog(appModel.GetChaosDryRunLog())
+			}
+
+			runErr := finishRun(appModel)
+
+			// Save the final frame of the progress table if requested
+			if renderFile != "" {
+				if err := appModel.WriteFinalFrame(renderFile, renderFilePlain); err != nil {
+					return err
+				}
+			}
+
+			return runErr
 		},
 	}
 
@@ -154,11 +518,134 @@ Examples:
 	cmd.Flags().BoolVar(&devOnly, "dev-only", false, "Create app for development only (skip production setup)")
 	cmd.Flags().StringVar(&template, "template", "", "Template to use (typescript, javascript, minimal)")
 
+	cmd.Flags().Int64Var(&seed, "seed", 0, "Random seed for the base simulation's step outcomes (0 = random)")
+	cmd.Flags().BoolVar(&useDefaults, "defaults", false, "Skip prompts and use the default configuration (for scripts and CI)")
+	cmd.Flags().DurationVar(&promptTimeout, "prompt-timeout", 0, "Give up on a prompt left unanswered this long (e.g. 2m; 0 = wait forever)")
+	cmd.Flags().StringVar(&promptTimeoutAction, "prompt-timeout-action", prompts.TimeoutUseDefaults.String(), "On prompt timeout: defaults (continue with defaults) or cancel")
+	cmd.Flags().BoolVar(&strict, "strict", false, "Treat configuration warnings as errors and exit before running")
+	cmd.Flags().IntVar(&minWidth, "min-width", models.DefaultMinTerminalWidth, "Narrowest terminal width for the full progress layout")
+	cmd.Flags().IntVar(&forcedWidth, "width", 0, "Render the progress table and AAR at this width regardless of terminal size, e.g. for screenshots (0 = auto-detect)")
+	cmd.Flags().IntVar(&maxStepsDisplay, "max-steps-display", 0, "Show at most this many steps, scrolling with the current one (0 = all)")
+	cmd.Flags().Float64Var(&componentSuccessRate, "component-success-rate", components.DefaultSuccessRateFactor, "Multiply every component success rate by this factor (clamped to 0-1)")
+	cmd.Flags().StringVar(&componentWindowsPath, "component-windows", "", "YAML file overriding when testing-phase components install (testing: {name: {start, end}})")
+	cmd.Flags().StringVar(&separator, "separator", string(styles.DefaultSeparator), "Character used for separator lines (e.g. ─, =, ·)")
+	cmd.Flags().StringVar(&themeName, "theme", "default", "Color palette: default, high-contrast (colorblind-friendly), monochrome")
+	cmd.Flags().BoolVar(&noSubsteps, "no-substeps", false, "Hide per-step sub-step lines while keeping other verbose output")
+	cmd.Flags().BoolVar(&planDot, "plan-dot", false, "Print the step and component plan as a Graphviz DOT graph and exit")
+	cmd.Flags().BoolVar(&listPhases, "list-phases", false, "Print each component installation phase with its steps and component windows and exit")
+	cmd.Flags().BoolVar(&browseCommands, "browse-commands", false, "After completion, browse suggested commands and copy one to the clipboard")
+	cmd.Flags().BoolVar(&interactiveComplete, "interactive-complete", false, "After a successful run, show a menu (view AAR, save report, re-run, copy dev command) instead of exiting")
+	cmd.Flags().BoolVar(&failFast, "fail-fast", true, "Stop at the first failed step (default)")
+	cmd.Flags().BoolVar(&keepGoing, "keep-going", false, "Continue past failed steps and report every failure in the AAR")
+	cmd.MarkFlagsMutuallyExclusive("fail-fast", "keep-going")
+	cmd.Flags().BoolVar(&compactAAR, "compact-aar", false, "Print a few-line AAR with only the outcome, step count, duration and top next step")
+	cmd.Flags().BoolVar(&summaryOnly, "summary-only", false, "Skip the live animation and print only the AAR")
+	cmd.Flags().StringVar(&aarFlag, "aar", aar.DisplayOn.String(), "Print the AAR after the run: on, off (animation only), only (AAR only, same as --summary-only)")
+	cmd.Flags().BoolVar(&progressOnly, "progress-only", false, "Skip prompts and the TUI and stream NDJSON progress lines ({\"overall\",\"step\",\"name\",\"delta_ms\",\"delta_overall\"}) to stdout")
+	cmd.Flags().StringVar(&renderFile, "render-file", "", "Write the final rendered progress table to a file")
+	cmd.Flags().BoolVar(&renderFilePlain, "render-file-plain", false, "Strip ANSI colors from the --render-file output")
+	cmd.Flags().StringVar(&reportFormat, "report-format", "", "Also write the AAR as files, comma-separated: json, markdown, csv")
+	cmd.Flags().StringVar(&reportDir, "report-dir", ".engx", "Directory for --report-format files (aar.json, aar.md, aar.csv)")
+
 	// Add chaos marine flags
 	cmd.Flags().BoolVar(&chaosMarine, "chaos-marine", false, "Enable chaos injection for failure simulation")
 	cmd.Flags().StringVar(&chaosLevel, "chaos-level", "default", "Chaos aggressiveness level (off, default, scout, aggressive, invasive, apocalyptic)")
-	cmd.Flags().Int64Var(&chaosSeed, "chaos-seed", 0, "Random seed for deterministic chaos (0 = random)")
+	cmd.Flags().Int64Var(&chaosSeed, "chaos-seed", 0, "Random seed for the chaos injector only (defaults to --seed)")
 	cmd.Flags().StringVar(&chaosConfig, "chaos-config", "", "Path to chaos configuration file")
+	cmd.Flags().BoolVar(&chaosDryRun, "chaos-dry-run", false, "Report where chaos would be injected without failing any step")
+	cmd.Flags().StringVar(&chaosTraceFile, "chaos-trace-file", "", "Append the stack trace of every chaos-failed step to this file")
+	cmd.Flags().DurationVar(&chaosOffAfter, "chaos-off-after", 0, "Stop injecting chaos once this much time has elapsed (e.g. 5s; 0 = never)")
+	cmd.Flags().StringVar(&chaosSteps, "chaos-steps", "", "Only inject chaos into these steps, comma-separated (e.g. \"Installing dependencies,Setting up environment\")")
+	cmd.Flags().IntVar(&chaosRecoveryAttempt, "chaos-recovery-attempt", 0, "Make recovery fail until this attempt, then succeed, for scripted demos (0 = random)")
 
 	return cmd
-}
\ No newline at end of file
+}
+
+// renderCreatePlanDOT renders the create command's step plan as a DOT graph
+func renderCreatePlanDOT(devOnly bool) string {
+	tracker := progresssim.NewCreateTracker(devOnly)
+	stepNames := make([]string, tracker.TotalSteps())
+	for i := range stepNames {
+		stepNames[i] = tracker.GetStep(i).Name
+	}
+	return components.RenderPlanDOT(stepNames, components.NewComponentManager(components.DefaultSuccessRateFactor))
+}
+
+// renderCreatePhaseList lists the create command's component installation phases
+func renderCreatePhaseList(devOnly bool) string {
+	tracker := progresssim.NewCreateTracker(devOnly)
+	stepNames := make([]string, tracker.TotalSteps())
+	for i := range stepNames {
+		stepNames[i] = tracker.GetStep(i).Name
+	}
+	return components.RenderPhaseList(stepNames, components.NewComponentManager(components.DefaultSuccessRateFactor))
+}
+
+// resolveAARDisplay combines --aar with --summary-only, which is the same as
+// --aar=only; the run skips the animation when the result is DisplayOnly
+func resolveAARDisplay(aarFlag string, summaryOnly bool) (aar.DisplayMode, error) {
+	mode, err := aar.ParseDisplayMode(aarFlag)
+	if err != nil {
+		return mode, err
+	}
+	if !summaryOnly {
+		return mode, nil
+	}
+	if mode == aar.DisplayOff {
+		return mode, fmt.Errorf("--aar=off conflicts with --summary-only")
+	}
+	return aar.DisplayOnly, nil
+}
+
+// printChaosDryRunLog prints each point where chaos would have been injected
+func printChaosDryRunLog(events []chaos.DryRunEvent) {
+	fmt.Fprintf(os.Stderr, "Chaos dry run: %d would-inject point(s)\n", len(events))
+	for _, event := range events {
+		fmt.Fprintf(os.Stderr, "  [step %d] %s -> %s (%s)\n",
+			event.StepIndex+1, event.Operation, event.Scenario, event.Reason)
+	}
+}
+
+// loadPriorBehavior returns the behavior pattern from the last exported chaos
+// session, or nil when there is none to learn from
+func loadPriorBehavior() *chaos.BehaviorPattern {
+	metrics, err := chaos.LoadLatestMetrics(chaosMetricsDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return nil
+	}
+	if metrics == nil {
+		return nil
+	}
+	return metrics.UserBehaviorPattern
+}
+
+// exportChaosMetrics saves a chaos run's metrics for the next difficulty
+// preview; failures only warn
+func exportChaosMetrics(model *models.AppModel) {
+	path := filepath.Join(chaosMetricsDir, chaos.MetricsFileName(time.Now()))
+	if err := model.ExportChaosMetrics(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// writeReports writes the run's AAR in each requested format; failures only warn
+func writeReports(model *models.AppModel, dir string, formats []aar.ReportFormat) {
+	if len(formats) == 0 {
+		return
+	}
+
+	summary := model.GetAARSummary()
+	if summary == nil {
+		fmt.Fprintln(os.Stderr, "Warning: no AAR was generated; skipping --report-format files")
+		return
+	}
+
+	paths, err := aar.WriteReports(summary, dir, formats)
+	for _, path := range paths {
+		fmt.Fprintf(os.Stderr, "Report written: %s\n", path)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
@@ -0,0 +1,209 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// runCreateCommand runs create with args, keeping its .engx state in dir
+// rather than the tree, and returns what it wrote to stdout
+func runCreateCommand(t *testing.T, dir string, args ...string) (string, error) {
+	t.Helper()
+
+	previousManifest, previousMetrics := runManifestPath, chaosMetricsDir
+	runManifestPath = filepath.Join(dir, ".engx", "last-run.json")
+	chaosMetricsDir = filepath.Join(dir, ".engx", "chaos-metrics")
+	defer func() { runManifestPath, chaosMetricsDir = previousManifest, previousMetrics }()
+
+	// Mirror the root command's global flags that create reads
+	root := &cobra.Command{Use: "engx", SilenceErrors: true, SilenceUsage: true}
+	root.PersistentFlags().Bool("quiet", false, "")
+	root.PersistentFlags().Bool("concise", false, "")
+	root.PersistentFlags().Bool("verbose", false, "")
+	root.PersistentFlags().Bool("debug", false, "")
+	root.PersistentFlags().String("config", "", "")
+	root.PersistentFlags().String("color", "auto", "")
+	root.PersistentFlags().String("output", "text", "")
+	root.PersistentFlags().String("glyphs", "unicode", "")
+	root.AddCommand(NewCreateCommand())
+	root.SetArgs(append([]string{"create"}, args...))
+
+	var stdout bytes.Buffer
+	root.SetOut(&stdout)
+	err := root.Execute()
+	return stdout.String(), err
+}
+
+func TestResolveAARDisplay(t *testing.T) {
+	tests := []struct {
+		aarFlag     string
+		summaryOnly bool
+		want        aar.DisplayMode
+		wantErr     bool
+	}{
+		{aarFlag: "on", want: aar.DisplayOn},
+		{aarFlag: "off", want: aar.DisplayOff},
+		{aarFlag: "only", want: aar.DisplayOnly},
+		{aarFlag: "on", summaryOnly: true, want: aar.DisplayOnly},
+		{aarFlag: "only", summaryOnly: true, want: aar.DisplayOnly},
+		{aarFlag: "off", summaryOnly: true, wantErr: true},
+		{aarFlag: "sometimes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := resolveAARDisplay(tt.aarFlag, tt.summaryOnly)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("resolveAARDisplay(%q, %t) error = nil, want an error", tt.aarFlag, tt.summaryOnly)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("resolveAARDisplay(%q, %t) error = %v", tt.aarFlag, tt.summaryOnly, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resolveAARDisplay(%q, %t) = %s, want %s", tt.aarFlag, tt.summaryOnly, got, tt.want)
+		}
+	}
+}
+
+func TestCreatePreviewsDifficultyFromLastChaosRun(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{"demo-app", "--defaults", "--summary-only", "--chaos-marine", "--chaos-level=scout", "--seed=7"}
+
+	// Injected failures may fail the run; only the preview and export matter
+	first, _ := runCreateCommand(t, dir, args...)
+	if !strings.Contains(first, "Chaos difficulty: scout") {
+		t.Fatalf("first run output has no difficulty preview:\n%s", first)
+	}
+
+	exported, err := chaos.LoadLatestMetrics(filepath.Join(dir, ".engx", "chaos-metrics"))
+	if err != nil || exported == nil {
+		t.Fatalf("LoadLatestMetrics() after a chaos run = %v, %v; want the run's metrics", exported, err)
+	}
+
+	// Make the last session look like a confident expert; the next preview
+	// should expect escalation instead of repeating --chaos-level
+	expert := &chaos.ChaosMetrics{
+		Enabled: true,
+		UserBehaviorPattern: &chaos.BehaviorPattern{
+			SkillLevel:        chaos.Expert,
+			RecentSuccessRate: 0.95,
+		},
+	}
+	data, err := json.Marshal(expert)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	path := filepath.Join(dir, ".engx", "chaos-metrics", "chaos-metrics-99991231-235959.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("writing prior metrics: %v", err)
+	}
+
+	second, _ := runCreateCommand(t, dir, args...)
+	if want := "Chaos difficulty: scout, likely adapting to aggressive (expert learner)"; !strings.Contains(second, want) {
+		t.Errorf("second run output missing %q:\n%s", want, second)
+	}
+}
+
+func TestCreateFailFastFalseKeepsGoing(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+	args := []string{"demo-app", "--defaults", "--summary-only", "--chaos-marine", "--chaos-level=apocalyptic", "--seed=7"}
+
+	tests := []struct {
+		name       string
+		flags      []string
+		wantStatus string
+	}{
+		{name: "default", wantStatus: "failed"},
+		{name: "fail-fast=false", flags: []string{"--fail-fast=false"}, wantStatus: "completed_with_errors"},
+		{name: "keep-going", flags: []string{"--keep-going"}, wantStatus: "completed_with_errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if _, err := runCreateCommand(t, dir, append(args, tt.flags...)...); err == nil {
+				t.Fatal("create error = nil, want the forced failures to fail the run")
+			}
+
+			manifest := readManifest(t, filepath.Join(dir, ".engx", "last-run.json"))
+			outcome, _ := manifest["outcome"].(map[string]interface{})
+			if status := outcome["status"]; status != tt.wantStatus {
+				t.Errorf("outcome status = %v, want %s", status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// assertJSONLines fails unless every line of stdout is a JSON object
+func assertJSONLines(t *testing.T, stdout string) {
+	t.Helper()
+
+	lines := strings.Split(strings.TrimRight(stdout, "\n"), "\n")
+	if len(lines) == 0 || lines[0] == "" {
+		t.Fatal("stdout is empty, want JSON lines")
+	}
+	for i, line := range lines {
+		var fields map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			t.Errorf("stdout line %d is not JSON (%v): %q", i+1, err, line)
+		}
+	}
+}
+
+func TestCreateProgressOnlyStdoutIsOnlyJSON(t *testing.T) {
+	stdout, err := runCreateCommand(t, t.TempDir(), "demo-app", "--progress-only", "--dev-only", "--seed=1")
+	if err != nil {
+		t.Fatalf("create --progress-only error = %v", err)
+	}
+	assertJSONLines(t, stdout)
+}
+
+func TestCreateJSONOutputStdoutIsOnlyJSON(t *testing.T) {
+	stdout, err := runCreateCommand(t, t.TempDir(), "demo-app", "--output=json", "--dev-only", "--seed=1")
+	if err != nil {
+		t.Fatalf("create --output=json error = %v", err)
+	}
+	assertJSONLines(t, stdout)
+}
+
+func TestCreateChecksChaosConfigEarly(t *testing.T) {
+	dir := t.TempDir()
+	malformed := filepath.Join(dir, "chaos.json")
+	if err := os.WriteFile(malformed, []byte(`{"aggressiveness_level": `), 0644); err != nil {
+		t.Fatalf("writing chaos config: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{name: "missing file", path: filepath.Join(dir, "nonexistent.json"), wantErr: config.ErrConfigNotFound},
+		{name: "malformed file", path: malformed, wantErr: config.ErrConfigParse},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runCreateCommand(t, dir, "demo-app", "--plan-dot", "--chaos-marine", "--chaos-config="+tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("create error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.path) {
+				t.Errorf("error %q does not name the resolved path %s", err, tt.path)
+			}
+		})
+	}
+}
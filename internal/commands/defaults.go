@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultsProjectName is the placeholder project the smart defaults are shown for
+const defaultsProjectName = "my-app"
+
+// NewDefaultsCommand creates the 'defaults' command that prints every built-in default
+func NewDefaultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "defaults",
+		Short: "Print every default the app uses as YAML",
+		Long: `Print every default the app uses as one YAML document: the config file
+defaults, each verbosity level, chaos settings, AAR performance targets and the
+smart project defaults used by --defaults.
+
+Examples:
+  engx defaults
+  engx defaults > engx-defaults.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			document, err := buildDefaultsDocument()
+			if err != nil {
+				return err
+			}
+
+			encoder := yaml.NewEncoder(cmd.OutOrStdout())
+			encoder.SetIndent(2)
+			if err := encoder.Encode(document); err != nil {
+				return fmt.Errorf("failed to encode defaults: %w", err)
+			}
+			return encoder.Close()
+		},
+	}
+
+	return cmd
+}
+
+// defaultsDocument is the YAML layout printed by 'engx defaults'
+type defaultsDocument struct {
+	Config             *config.Config         `yaml:"config"`
+	Verbosity          map[string]interface{} `yaml:"verbosity"`
+	Chaos              *chaos.ChaosConfig     `yaml:"chaos"`
+	PerformanceTargets map[string]string      `yaml:"performance_targets"`
+	SmartDefaults      interface{}            `yaml:"smart_defaults"`
+}
+
+// buildDefaultsDocument composes the defaults of each subsystem. Types that
+// only carry JSON tags go through JSON so their keys match the other outputs.
+func buildDefaultsDocument() (*defaultsDocument, error) {
+	document := &defaultsDocument{
+		Config:             config.NewDefaultConfig(),
+		Chaos:              chaos.NewDefaultConfig(),
+		Verbosity:          make(map[string]interface{}),
+		PerformanceTargets: make(map[string]string),
+	}
+
+	levels := []config.VerbosityLevel{
+		config.VerbosityQuiet,
+		config.VerbosityConcise,
+		config.VerbosityDefault,
+		config.VerbosityVerbose,
+		config.VerbosityDebug,
+	}
+	for _, level := range levels {
+		value, err := jsonToYAMLValue(config.NewVerbosityConfig(level))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s verbosity defaults: %w", level, err)
+		}
+		document.Verbosity[level.String()] = value
+	}
+
+	for name, target := range aar.DefaultPerformanceTargets() {
+		document.PerformanceTargets[name] = target.String()
+	}
+
+	smartDefaults, err := jsonToYAMLValue(config.GetSmartDefaults(defaultsProjectName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert smart defaults: %w", err)
+	}
+	document.SmartDefaults = smartDefaults
+
+	return document, nil
+}
+
+// jsonToYAMLValue round-trips v through JSON into generic maps for YAML output
+func jsonToYAMLValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDefaultsCommandPrintsEverySection(t *testing.T) {
+	var out bytes.Buffer
+	cmd := NewDefaultsCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs(nil)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("defaults failed: %v", err)
+	}
+
+	var sections map[string]yaml.Node
+	if err := yaml.Unmarshal(out.Bytes(), &sections); err != nil {
+		t.Fatalf("defaults output is not valid YAML: %v\n%s", err, out.String())
+	}
+	for _, key := range []string{"config", "verbosity", "chaos", "performance_targets", "smart_defaults"} {
+		if _, ok := sections[key]; !ok {
+			t.Errorf("defaults output is missing the %q section", key)
+		}
+	}
+
+	// Sections backed by YAML-tagged types parse back into the defaults they came from
+	cfgNode := sections["config"]
+	var cfg config.Config
+	if err := cfgNode.Decode(&cfg); err != nil {
+		t.Fatalf("config section does not re-parse: %v", err)
+	}
+	if got, want := mustMarshalYAML(t, &cfg), mustMarshalYAML(t, config.NewDefaultConfig()); got != want {
+		t.Errorf("config section re-parsed as\n%s\nwant\n%s", got, want)
+	}
+
+	chaosNode := sections["chaos"]
+	var chaosCfg chaos.ChaosConfig
+	if err := chaosNode.Decode(&chaosCfg); err != nil {
+		t.Fatalf("chaos section does not re-parse: %v", err)
+	}
+	if want := chaos.NewDefaultConfig(); !reflect.DeepEqual(&chaosCfg, want) {
+		t.Errorf("chaos section = %+v, want %+v", chaosCfg, want)
+	}
+
+	var verbosity map[string]interface{}
+	verbosityNode := sections["verbosity"]
+	if err := verbosityNode.Decode(&verbosity); err != nil {
+		t.Fatalf("verbosity section does not re-parse: %v", err)
+	}
+	for _, level := range []string{"quiet", "concise", "default", "verbose", "debug"} {
+		if _, ok := verbosity[level]; !ok {
+			t.Errorf("verbosity section is missing the %q level", level)
+		}
+	}
+}
+
+func mustMarshalYAML(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() failed: %v", err)
+	}
+	return string(data)
+}
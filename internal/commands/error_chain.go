@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormatErrorChain renders err followed by every error it wraps, one per
+// indented line, including both branches of errors joined with multiple %w
+func FormatErrorChain(err error) string {
+	var output strings.Builder
+	writeErrorChain(&output, err, 0)
+	return output.String()
+}
+
+// writeErrorChain writes err at depth and recurses into what it wraps
+func writeErrorChain(output *strings.Builder, err error, depth int) {
+	if err == nil {
+		return
+	}
+
+	fmt.Fprintf(output, "%s%s (%T)\n", strings.Repeat("  ", depth), err.Error(), err)
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			writeErrorChain(output, inner, depth+1)
+		}
+	case interface{ Unwrap() error }:
+		writeErrorChain(output, wrapped.Unwrap(), depth+1)
+	}
+}
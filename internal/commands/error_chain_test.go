@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+)
+
+func TestFormatErrorChainPrintsEveryWrappedCause(t *testing.T) {
+	root := errors.New("connection refused")
+	err := fmt.Errorf("create failed: %w", fmt.Errorf("installing dependencies: %w", root))
+
+	got := FormatErrorChain(err)
+	want := "create failed: installing dependencies: connection refused (*fmt.wrapError)\n" +
+		"  installing dependencies: connection refused (*fmt.wrapError)\n" +
+		"    connection refused (*errors.errorString)\n"
+	if got != want {
+		t.Errorf("FormatErrorChain() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFormatErrorChainFollowsJoinedErrors(t *testing.T) {
+	err := errors.Join(errors.New("first"), errors.New("second"))
+
+	chain := FormatErrorChain(err)
+	for _, want := range []string{"\n  first (*errors.errorString)\n", "\n  second (*errors.errorString)\n"} {
+		if !strings.Contains(chain, want) {
+			t.Errorf("error chain is missing branch %q:\n%s", strings.TrimSpace(want), chain)
+		}
+	}
+}
+
+func TestFormatErrorChainReachesConfigParseCause(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.yaml")
+	if err := os.WriteFile(path, []byte("defaults: [unclosed\n"), 0644); err != nil {
+		t.Fatalf("writing config: %v", err)
+	}
+
+	_, err := config.CheckConfigFile(path)
+	if err == nil {
+		t.Fatal("CheckConfigFile() error = nil for a malformed file")
+	}
+
+	chain := FormatErrorChain(err)
+	for _, want := range []string{"  " + config.ErrConfigParse.Error(), "yaml:"} {
+		if !strings.Contains(chain, want) {
+			t.Errorf("error chain is missing %q:\n%s", want, chain)
+		}
+	}
+}
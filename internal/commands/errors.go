@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	simerrors "github.com/bthompso/engx-ergonomics-poc/internal/simulation/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewErrorsCommand creates the 'errors' command for inspecting the error scenario catalog
+func NewErrorsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "errors",
+		Short: "Inspect the simulated error scenario catalog",
+	}
+
+	cmd.AddCommand(newErrorsListCommand())
+
+	return cmd
+}
+
+// newErrorsListCommand creates the 'errors list' subcommand
+func newErrorsListCommand() *cobra.Command {
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the error scenarios used by the create simulation",
+		Long: `List the error scenarios used by the create simulation.
+
+Examples:
+  engx errors list
+  engx errors list --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scenarios := simerrors.ListErrorScenarios()
+
+			if asJSON {
+				encoder := json.NewEncoder(cmd.OutOrStdout())
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(scenarios); err != nil {
+					return fmt.Errorf("failed to encode error catalog: %w", err)
+				}
+				return nil
+			}
+
+			writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(writer, "CODE\tSEVERITY\tTITLE")
+			for _, scenario := range scenarios {
+				fmt.Fprintf(writer, "%s\t%s\t%s\n", scenario.Code, scenario.Severity.String(), scenario.Title)
+			}
+			return writer.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the full catalog as JSON")
+
+	return cmd
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	simerrors "github.com/bthompso/engx-ergonomics-poc/internal/simulation/errors"
+)
+
+func TestErrorsListJSONWritesToCommandOutput(t *testing.T) {
+	cmd := NewErrorsCommand()
+	cmd.SetArgs([]string{"list", "--json"})
+	var stdout bytes.Buffer
+	cmd.SetOut(&stdout)
+
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("errors list --json error = %v", err)
+	}
+
+	var scenarios []simerrors.ErrorScenario
+	if err := json.Unmarshal(stdout.Bytes(), &scenarios); err != nil {
+		t.Fatalf("output is not the JSON catalog (%v):\n%s", err, stdout.String())
+	}
+	if len(scenarios) != len(simerrors.CreateErrorScenarios) {
+		t.Errorf("output lists %d scenarios, want %d", len(scenarios), len(simerrors.CreateErrorScenarios))
+	}
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/models"
+)
+
+// runManifestPath is where the most recent run's manifest is written
+var runManifestPath = filepath.Join(".engx", "last-run.json")
+
+// RunManifest records what a run was asked to do and how it ended
+type RunManifest struct {
+	StartedAt  time.Time                 `json:"started_at"`
+	FinishedAt *time.Time                `json:"finished_at,omitempty"`
+	Command    string                    `json:"command"`
+	AppName    string                    `json:"app_name"`
+	Flags      []string                  `json:"flags"`
+	Seed       int64                     `json:"seed"`
+	Chaos      ManifestChaos             `json:"chaos"`
+	Config     *config.UserConfiguration `json:"config,omitempty"`
+	Outcome    *models.RunOutcome        `json:"outcome,omitempty"`
+}
+
+// ManifestChaos captures the chaos settings a run used
+type ManifestChaos struct {
+	Enabled  bool     `json:"enabled"`
+	Level    string   `json:"level,omitempty"`
+	Seed     int64    `json:"seed,omitempty"`
+	Config   string   `json:"config,omitempty"`
+	DryRun   bool     `json:"dry_run,omitempty"`
+	OffAfter string   `json:"off_after,omitempty"`
+	Steps    []string `json:"steps,omitempty"`
+
+	RecoveryAttempt int `json:"recovery_attempt,omitempty"`
+}
+
+// ReproBanner returns a one-line summary of the seeds and key settings needed
+// to reproduce the run, or "" when nothing is seeded
+func (m *RunManifest) ReproBanner() string {
+	chaosSeeded := m.Chaos.Enabled && m.Chaos.Seed != 0
+	if m.Seed == 0 && !chaosSeeded {
+		return ""
+	}
+
+	var parts []string
+	if m.Seed != 0 {
+		parts = append(parts, fmt.Sprintf("seed=%d", m.Seed))
+	}
+	if m.Chaos.Enabled {
+		parts = append(parts, fmt.Sprintf("chaos-level=%s", m.Chaos.Level))
+		if chaosSeeded {
+			parts = append(parts, fmt.Sprintf("chaos-seed=%d", m.Chaos.Seed))
+		}
+		if len(m.Chaos.Steps) > 0 {
+			parts = append(parts, fmt.Sprintf("chaos-steps=%q", strings.Join(m.Chaos.Steps, ",")))
+		}
+	}
+	if m.Config != nil {
+		parts = append(parts, fmt.Sprintf("template=%s", m.Config.Template.Type))
+		parts = append(parts, fmt.Sprintf("dev-only=%t", m.Config.ProductionSetup.IsDevOnly()))
+	}
+
+	return fmt.Sprintf("Reproduce: %s (full settings in %s)", strings.Join(parts, " "), runManifestPath)
+}
+
+// printReproBanner writes the manifest's reproducibility banner to w; quiet
+// runs and machine-readable output modes skip it
+func printReproBanner(w io.Writer, manifest *RunManifest, quiet bool) {
+	if quiet {
+		return
+	}
+	if banner := manifest.ReproBanner(); banner != "" {
+		fmt.Fprintln(w, banner)
+	}
+}
+
+// writeRunManifest atomically writes the manifest so readers never see a partial file
+func writeRunManifest(manifest *RunManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal run manifest: %w", err)
+	}
+
+	dir := filepath.Dir(runManifestPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create manifest directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, "last-run-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to create temp manifest: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write run manifest: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write run manifest: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), runManifestPath); err != nil {
+		return fmt.Errorf("failed to replace run manifest: %w", err)
+	}
+
+	return nil
+}
+
+// finishRunManifest stamps the run's outcome onto the manifest and rewrites it
+func finishRunManifest(manifest *RunManifest, outcome models.RunOutcome) error {
+	finished := time.Now()
+	manifest.FinishedAt = &finished
+	manifest.Outcome = &outcome
+	return writeRunManifest(manifest)
+}
@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/models"
+)
+
+// useTempManifestPath points the run manifest at a temporary directory
+func useTempManifestPath(t *testing.T) string {
+	t.Helper()
+
+	previous := runManifestPath
+	runManifestPath = filepath.Join(t.TempDir(), ".engx", "last-run.json")
+	t.Cleanup(func() { runManifestPath = previous })
+	return runManifestPath
+}
+
+// readManifest decodes the manifest file into a generic map
+func readManifest(t *testing.T, path string) map[string]interface{} {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading manifest: %v", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("decoding manifest: %v", err)
+	}
+	return fields
+}
+
+func TestRunManifestWrittenAtStartAndFinished(t *testing.T) {
+	path := useTempManifestPath(t)
+
+	manifest := &RunManifest{
+		StartedAt: time.Now(),
+		Command:   "create",
+		AppName:   "TestApp",
+		Flags:     []string{"--seed=42"},
+		Seed:      42,
+		Chaos:     ManifestChaos{Enabled: true, Level: "scout", Seed: 7},
+	}
+	if err := writeRunManifest(manifest); err != nil {
+		t.Fatalf("writeRunManifest() error = %v", err)
+	}
+
+	started := readManifest(t, path)
+	for _, key := range []string{"started_at", "command", "app_name", "flags", "seed", "chaos"} {
+		if _, ok := started[key]; !ok {
+			t.Errorf("start manifest is missing %q", key)
+		}
+	}
+	for _, key := range []string{"finished_at", "outcome"} {
+		if _, ok := started[key]; ok {
+			t.Errorf("start manifest already has %q", key)
+		}
+	}
+
+	outcome := models.RunOutcome{Status: "success", CompletedSteps: 5, TotalSteps: 5}
+	if err := finishRunManifest(manifest, outcome); err != nil {
+		t.Fatalf("finishRunManifest() error = %v", err)
+	}
+
+	finished := readManifest(t, path)
+	if _, ok := finished["finished_at"]; !ok {
+		t.Error("finished manifest is missing finished_at")
+	}
+	got, ok := finished["outcome"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("finished manifest outcome = %v, want an object", finished["outcome"])
+	}
+	if got["status"] != "success" || got["completed_steps"] != float64(5) {
+		t.Errorf("outcome = %v, want success with 5 completed steps", got)
+	}
+
+	// The atomic write leaves no temp files behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("reading manifest dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("manifest dir has %d entries, want only last-run.json", len(entries))
+	}
+}
+
+func TestReproBannerShowsSeeds(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest RunManifest
+		want     []string
+		wantNone bool
+	}{
+		{
+			name:     "unseeded",
+			manifest: RunManifest{Chaos: ManifestChaos{Enabled: true, Level: "scout"}},
+			wantNone: true,
+		},
+		{
+			name:     "base seed",
+			manifest: RunManifest{Seed: 42},
+			want:     []string{"seed=42"},
+		},
+		{
+			name: "base and chaos seeds",
+			manifest: RunManifest{
+				Seed:  42,
+				Chaos: ManifestChaos{Enabled: true, Level: "aggressive", Seed: 7, Steps: []string{"Installing dependencies"}},
+			},
+			want: []string{"seed=42", "chaos-level=aggressive", "chaos-seed=7", `chaos-steps="Installing dependencies"`},
+		},
+		{
+			name:     "chaos seed only",
+			manifest: RunManifest{Chaos: ManifestChaos{Enabled: true, Level: "scout", Seed: 7}},
+			want:     []string{"chaos-seed=7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			banner := tt.manifest.ReproBanner()
+			if tt.wantNone {
+				if banner != "" {
+					t.Errorf("ReproBanner() = %q, want no banner for an unseeded run", banner)
+				}
+				return
+			}
+			if strings.Contains(banner, "\n") {
+				t.Errorf("ReproBanner() = %q, want a single line", banner)
+			}
+			for _, want := range append(tt.want, runManifestPath) {
+				if !strings.Contains(banner, want) {
+					t.Errorf("ReproBanner() = %q, want it to contain %q", banner, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrintReproBannerIsSuppressedWhenQuiet(t *testing.T) {
+	manifest := &RunManifest{Seed: 42}
+
+	var out bytes.Buffer
+	printReproBanner(&out, manifest, false)
+	if !strings.Contains(out.String(), "seed=42") {
+		t.Errorf("printReproBanner() wrote %q, want the banner", out.String())
+	}
+
+	out.Reset()
+	printReproBanner(&out, manifest, true)
+	if out.Len() != 0 {
+		t.Errorf("printReproBanner() in quiet mode wrote %q, want nothing", out.String())
+	}
+}
+
+func TestReproBannerIncludesKeyConfig(t *testing.T) {
+	userConfig := prompts.DefaultUserConfiguration()
+	manifest := &RunManifest{Seed: 42, Config: userConfig}
+
+	banner := manifest.ReproBanner()
+	for _, want := range []string{
+		fmt.Sprintf("template=%s", userConfig.Template.Type),
+		fmt.Sprintf("dev-only=%t", userConfig.ProductionSetup.IsDevOnly()),
+	} {
+		if !strings.Contains(banner, want) {
+			t.Errorf("ReproBanner() = %q, want it to contain %q", banner, want)
+		}
+	}
+}
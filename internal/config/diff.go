@@ -0,0 +1,98 @@
+package config
+
+// ChangeKind identifies how a configuration value changed
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeRemoved
+	ChangeModified
+)
+
+// String returns the diff marker for the change kind
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "+"
+	case ChangeRemoved:
+		return "-"
+	case ChangeModified:
+		return "~"
+	default:
+		return "?"
+	}
+}
+
+// ConfigChange describes one difference between two configurations
+type ConfigChange struct {
+	Kind  ChangeKind
+	Label string
+	From  string // Only set for ChangeModified
+	To    string // Only set for ChangeModified
+}
+
+// DiffUserConfigurations lists what changed from old to new, template and
+// navigation first, then feature additions and removals in selection order
+func DiffUserConfigurations(old, new UserConfiguration) []ConfigChange {
+	var changes []ConfigChange
+
+	if old.Template.Type != new.Template.Type {
+		changes = append(changes, ConfigChange{
+			Kind:  ChangeModified,
+			Label: "template",
+			From:  old.Template.Type.String(),
+			To:    new.Template.Type.String(),
+		})
+	}
+
+	if old.Navigation.UseFederatedNav != new.Navigation.UseFederatedNav {
+		changes = append(changes, ConfigChange{
+			Kind:  ChangeModified,
+			Label: "navigation",
+			From:  navigationName(old.Navigation),
+			To:    navigationName(new.Navigation),
+		})
+	}
+
+	changes = append(changes, diffSelections(old.DevFeatures.GetSelected(), new.DevFeatures.GetSelected())...)
+	changes = append(changes, diffSelections(old.ProductionSetup.GetSelected(), new.ProductionSetup.GetSelected())...)
+	changes = append(changes, diffSelections(old.Testing.GetSelected(), new.Testing.GetSelected())...)
+
+	return changes
+}
+
+// diffSelections returns additions then removals between two selection lists
+func diffSelections(old, new []string) []ConfigChange {
+	var changes []ConfigChange
+
+	for _, item := range new {
+		if !containsString(old, item) {
+			changes = append(changes, ConfigChange{Kind: ChangeAdded, Label: item})
+		}
+	}
+	for _, item := range old {
+		if !containsString(new, item) {
+			changes = append(changes, ConfigChange{Kind: ChangeRemoved, Label: item})
+		}
+	}
+
+	return changes
+}
+
+// navigationName returns a short name for the navigation choice
+func navigationName(n NavigationConfig) string {
+	if n.UseFederatedNav {
+		return "federated"
+	}
+	return "standalone"
+}
+
+// containsString reports whether items includes target
+func containsString(items []string, target string) bool {
+	for _, item := range items {
+		if item == target {
+			return true
+		}
+	}
+	return false
+}
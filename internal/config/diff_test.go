@@ -0,0 +1,30 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffUserConfigurations(t *testing.T) {
+	old := UserConfiguration{
+		Template:        TemplateConfig{Type: JavaScript},
+		ProductionSetup: ProductionConfig{Analytics: true},
+	}
+	new := UserConfiguration{
+		Template:        TemplateConfig{Type: TypeScript},
+		ProductionSetup: ProductionConfig{Docker: true},
+	}
+
+	want := []ConfigChange{
+		{Kind: ChangeModified, Label: "template", From: "javascript", To: "typescript"},
+		{Kind: ChangeAdded, Label: "Docker"},
+		{Kind: ChangeRemoved, Label: "Analytics"},
+	}
+	if got := DiffUserConfigurations(old, new); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffUserConfigurations() = %+v, want %+v", got, want)
+	}
+
+	if got := DiffUserConfigurations(new, new); len(got) != 0 {
+		t.Errorf("DiffUserConfigurations() of identical configs = %+v, want none", got)
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -8,6 +9,53 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+// Errors returned by CheckConfigFile and CheckFile, distinguishable with errors.Is
+var (
+	ErrConfigNotFound   = errors.New("config file not found")
+	ErrConfigUnreadable = errors.New("config file is not readable")
+	ErrConfigParse      = errors.New("config file could not be parsed")
+)
+
+// CheckConfigFile verifies a user-supplied config file exists, is readable and parses.
+// It returns the resolved absolute path so errors can point at the exact file.
+func CheckConfigFile(path string) (string, error) {
+	return CheckFile(path, func(data []byte) error {
+		var config Config
+		return yaml.Unmarshal(data, &config)
+	})
+}
+
+// CheckFile is CheckConfigFile for any config format: parse reports whether
+// the file's contents are valid, and its error is wrapped in ErrConfigParse
+func CheckFile(path string, parse func([]byte) error) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return absPath, fmt.Errorf("%w: %s", ErrConfigNotFound, absPath)
+		}
+		return absPath, fmt.Errorf("%w: %s: %w", ErrConfigUnreadable, absPath, err)
+	}
+	if info.IsDir() {
+		return absPath, fmt.Errorf("%w: %s is a directory", ErrConfigUnreadable, absPath)
+	}
+
+	data, err := os.ReadFile(absPath)
+	if err != nil {
+		return absPath, fmt.Errorf("%w: %s: %w", ErrConfigUnreadable, absPath, err)
+	}
+
+	if err := parse(data); err != nil {
+		return absPath, fmt.Errorf("%w: %s: %w", ErrConfigParse, absPath, err)
+	}
+
+	return absPath, nil
+}
+
 // Loader handles configuration loading with inheritance
 type Loader struct {
 	globalPath  string
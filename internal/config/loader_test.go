@@ -0,0 +1,70 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTestFile writes content to name in a fresh temp dir and returns its path
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+	return path
+}
+
+func TestCheckConfigFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name:    "missing file",
+			path:    func(t *testing.T) string { return filepath.Join(t.TempDir(), "nonexistent.yaml") },
+			wantErr: ErrConfigNotFound,
+		},
+		{
+			name:    "malformed file",
+			path:    func(t *testing.T) string { return writeTestFile(t, "bad.yaml", "defaults: [unclosed\n") },
+			wantErr: ErrConfigParse,
+		},
+		{
+			name:    "directory",
+			path:    func(t *testing.T) string { return t.TempDir() },
+			wantErr: ErrConfigUnreadable,
+		},
+		{
+			name: "valid file",
+			path: func(t *testing.T) string { return writeTestFile(t, "good.yaml", "version: \"1\"\n") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.path(t)
+
+			absPath, err := CheckConfigFile(path)
+			if !filepath.IsAbs(absPath) {
+				t.Errorf("CheckConfigFile() path = %q, want an absolute path", absPath)
+			}
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("CheckConfigFile() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckConfigFile() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), absPath) {
+				t.Errorf("error %q does not name the resolved path %s", err, absPath)
+			}
+		})
+	}
+}
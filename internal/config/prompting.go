@@ -52,6 +52,7 @@ type UserConfiguration struct {
 	ProductionSetup ProductionConfig `json:"productionSetup"`
 	Testing     TestingConfig  `json:"testing"`
 	Navigation  NavigationConfig `json:"navigation"`
+	SkippedPrompts []string      `json:"skippedPrompts,omitempty"` // Titles of optional prompts left at defaults
 }
 
 // TemplateConfig contains template-specific configuration
@@ -125,6 +126,9 @@ func (p ProductionConfig) GetSelected() []string {
 	if p.Docker {
 		selected = append(selected, "Docker")
 	}
+	if p.Azure {
+		selected = append(selected, "Azure")
+	}
 	if p.CI_CD {
 		selected = append(selected, "CI/CD Pipeline")
 	}
@@ -134,9 +138,23 @@ func (p ProductionConfig) GetSelected() []string {
 	if p.Analytics {
 		selected = append(selected, "Analytics")
 	}
+	if p.TrustBridge {
+		selected = append(selected, "TrustBridge SSO")
+	}
+	if p.GRPC {
+		selected = append(selected, "gRPC Web")
+	}
+	if p.GridHDFS {
+		selected = append(selected, "GRID/HDFS Access")
+	}
 	return selected
 }
 
+// IsDevOnly reports whether no production deployment features are selected
+func (p ProductionConfig) IsDevOnly() bool {
+	return !p.Docker && !p.CI_CD && !p.Monitoring && !p.Analytics
+}
+
 // GetSelectedTestingFeatures returns a list of selected testing features
 func (t TestingConfig) GetSelected() []string {
 	var selected []string
@@ -265,6 +283,21 @@ func ValidateConfiguration(config UserConfiguration) []string {
 	return warnings
 }
 
+// ValidateConfigurationStrict fails on any configuration warning, listing
+// them all so strict runs can be fixed in one pass
+func ValidateConfigurationStrict(config UserConfiguration) error {
+	warnings := ValidateConfiguration(config)
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	var lines []string
+	for _, warning := range warnings {
+		lines = append(lines, "  - "+warning)
+	}
+	return fmt.Errorf("configuration has %d warning(s) (--strict):\n%s", len(warnings), strings.Join(lines, "\n"))
+}
+
 // EstimateSetupTime calculates estimated setup time based on configuration
 func EstimateSetupTime(config UserConfiguration) int {
 	baseTime := 180 // 3 minutes base
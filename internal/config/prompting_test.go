@@ -0,0 +1,42 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateConfigurationStrict(t *testing.T) {
+	clean := GetSmartDefaults("TestApp")
+	if warnings := ValidateConfiguration(clean); len(warnings) != 0 {
+		t.Fatalf("smart defaults produce warnings %q, want a clean baseline", warnings)
+	}
+	if err := ValidateConfigurationStrict(clean); err != nil {
+		t.Errorf("ValidateConfigurationStrict() on a clean config = %v, want nil", err)
+	}
+
+	warned := clean
+	warned.ProductionSetup.Docker = true
+	warned.ProductionSetup.CI_CD = false
+
+	warnings := ValidateConfiguration(warned)
+	if len(warnings) == 0 {
+		t.Fatal("Docker without CI/CD produced no warning")
+	}
+	err := ValidateConfigurationStrict(warned)
+	if err == nil {
+		t.Fatal("ValidateConfigurationStrict() = nil, want the warning as an error")
+	}
+	for _, warning := range warnings {
+		if !strings.Contains(err.Error(), warning) {
+			t.Errorf("strict error %q does not list warning %q", err, warning)
+		}
+	}
+}
+
+func TestProductionGetSelectedIncludesAzureAndDataAccess(t *testing.T) {
+	selected := ProductionConfig{Azure: true, TrustBridge: true, GRPC: true, GridHDFS: true}.GetSelected()
+	want := []string{"Azure", "TrustBridge SSO", "gRPC Web", "GRID/HDFS Access"}
+	if strings.Join(selected, ",") != strings.Join(want, ",") {
+		t.Errorf("GetSelected() = %q, want %q", selected, want)
+	}
+}
@@ -21,6 +21,9 @@ type PromptConfig struct {
 // PromptConfiguration holds all prompt configurations
 type PromptConfiguration struct {
 	Prompts []PromptConfig `json:"prompts" yaml:"prompts"`
+
+	// Minimum selections per multi-select prompt ID, e.g. {"dev-features": 1}
+	MinSelected map[string]int `json:"min_selected,omitempty" yaml:"min_selected,omitempty"`
 }
 
 // LoadPromptConfiguration loads prompt config from JSON file
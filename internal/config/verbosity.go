@@ -66,6 +66,7 @@ type VerbosityConfig struct {
 	ShowComponents  bool           `json:"show_components"`
 	ShowDebugInfo   bool           `json:"show_debug_info"`
 	ShowSystemInfo  bool           `json:"show_system_info"`
+	ShowSubSteps    bool           `json:"show_sub_steps"`
 	DetailLevel     int            `json:"detail_level"`     // 1-5 scale
 	ProgressFormat  string         `json:"progress_format"`  // "simple", "detailed", "spinner"
 	OutputFormat    string         `json:"output_format"`    // "standard", "compact", "expanded"
@@ -85,6 +86,7 @@ func NewVerbosityConfig(level VerbosityLevel) *VerbosityConfig {
 		config.ShowComponents = false
 		config.ShowDebugInfo = false
 		config.ShowSystemInfo = false
+		config.ShowSubSteps = false
 		config.DetailLevel = 1
 		config.ProgressFormat = "simple"
 		config.OutputFormat = "compact"
@@ -95,6 +97,7 @@ func NewVerbosityConfig(level VerbosityLevel) *VerbosityConfig {
 		config.ShowComponents = false
 		config.ShowDebugInfo = false
 		config.ShowSystemInfo = false
+		config.ShowSubSteps = false
 		config.DetailLevel = 2
 		config.ProgressFormat = "simple"
 		config.OutputFormat = "standard"
@@ -105,6 +108,7 @@ func NewVerbosityConfig(level VerbosityLevel) *VerbosityConfig {
 		config.ShowComponents = true
 		config.ShowDebugInfo = false
 		config.ShowSystemInfo = false
+		config.ShowSubSteps = false
 		config.DetailLevel = 3
 		config.ProgressFormat = "detailed"
 		config.OutputFormat = "standard"
@@ -115,6 +119,7 @@ func NewVerbosityConfig(level VerbosityLevel) *VerbosityConfig {
 		config.ShowComponents = true
 		config.ShowDebugInfo = false
 		config.ShowSystemInfo = true
+		config.ShowSubSteps = true
 		config.DetailLevel = 4
 		config.ProgressFormat = "detailed"
 		config.OutputFormat = "expanded"
@@ -125,6 +130,7 @@ func NewVerbosityConfig(level VerbosityLevel) *VerbosityConfig {
 		config.ShowComponents = true
 		config.ShowDebugInfo = true
 		config.ShowSystemInfo = true
+		config.ShowSubSteps = true
 		config.DetailLevel = 5
 		config.ProgressFormat = "detailed"
 		config.OutputFormat = "expanded"
@@ -161,6 +167,27 @@ func DetermineVerbosityLevel(quiet, concise, verbose, debug bool) VerbosityLevel
 	return VerbosityDefault
 }
 
+// VerbosityEnvConflict reports when ENGX_VERBOSITY disagrees with an explicit CLI flag.
+// The returned message explains that the flag took precedence over the environment.
+func VerbosityEnvConflict(quiet, concise, verbose, debug bool) (string, bool) {
+	envValue := os.Getenv("ENGX_VERBOSITY")
+	if envValue == "" || !(quiet || concise || verbose || debug) {
+		return "", false
+	}
+
+	envLevel, err := ParseVerbosityLevel(envValue)
+	if err != nil {
+		return "", false
+	}
+
+	flagLevel := DetermineVerbosityLevel(quiet, concise, verbose, debug)
+	if envLevel == flagLevel {
+		return "", false
+	}
+
+	return fmt.Sprintf("ENGX_VERBOSITY=%s ignored: --%s flag takes precedence", envValue, flagLevel.String()), true
+}
+
 // ShouldShow determines if content should be shown based on verbosity configuration
 func (vc *VerbosityConfig) ShouldShow(contentType string) bool {
 	switch contentType {
@@ -174,6 +201,8 @@ func (vc *VerbosityConfig) ShouldShow(contentType string) bool {
 		return vc.ShowDebugInfo
 	case "system":
 		return vc.ShowSystemInfo
+	case "substeps":
+		return vc.ShowSubSteps
 	default:
 		return true // Show unknown content types by default
 	}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVerbosityEnvConflict(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          string
+		quiet        bool
+		verbose      bool
+		wantConflict bool
+	}{
+		{name: "env disagrees with flag", env: "quiet", verbose: true, wantConflict: true},
+		{name: "env agrees with flag", env: "verbose", verbose: true},
+		{name: "no flag set", env: "quiet"},
+		{name: "no env set", env: "", quiet: true},
+		{name: "invalid env value", env: "loud", verbose: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENGX_VERBOSITY", tt.env)
+
+			warning, conflict := VerbosityEnvConflict(tt.quiet, false, tt.verbose, false)
+			if conflict != tt.wantConflict {
+				t.Fatalf("conflict = %t, want %t (warning %q)", conflict, tt.wantConflict, warning)
+			}
+			if !conflict {
+				return
+			}
+			if !strings.Contains(warning, "ENGX_VERBOSITY="+tt.env) || !strings.Contains(warning, "--verbose flag takes precedence") {
+				t.Errorf("warning %q does not name the env value and winning flag", warning)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package prompts
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/bthompso/engx-ergonomics-poc/internal/config"
 )
@@ -29,8 +32,69 @@ type InlinePrompter struct {
 	config     *config.PromptConfiguration
 	userConfig *config.UserConfiguration
 	reader     *bufio.Reader
+	color      bool
+
+	// Non-interactive stdin: running out of piped answers falls back to defaults
+	nonInteractive bool
+
+	// Idle timeout per prompt (0 = wait forever) and what to do when it fires
+	timeout       time.Duration
+	timeoutAction TimeoutAction
+	after         func(time.Duration) <-chan time.Time
+
+	// Background stdin reader used while a timeout is set, stopped when
+	// RunPrompts returns so it doesn't outlive the prompts
+	lines      chan readResult
+	stopReader chan struct{}
 }
 
+// readResult is one line read from stdin by the background reader
+type readResult struct {
+	line string
+	err  error
+}
+
+// TimeoutAction is what happens when a prompt goes unanswered for too long
+type TimeoutAction int
+
+const (
+	TimeoutUseDefaults TimeoutAction = iota
+	TimeoutCancel
+)
+
+// String returns the flag value for the action
+func (a TimeoutAction) String() string {
+	switch a {
+	case TimeoutUseDefaults:
+		return "defaults"
+	case TimeoutCancel:
+		return "cancel"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseTimeoutAction parses a --prompt-timeout-action value
+func ParseTimeoutAction(s string) (TimeoutAction, error) {
+	switch strings.ToLower(s) {
+	case "defaults":
+		return TimeoutUseDefaults, nil
+	case "cancel":
+		return TimeoutCancel, nil
+	default:
+		return TimeoutUseDefaults, fmt.Errorf("invalid prompt timeout action: %s (expected defaults or cancel)", s)
+	}
+}
+
+// errInputExhausted signals that piped input ended before all prompts were answered
+var errInputExhausted = errors.New("input exhausted")
+
+// ErrPromptTimeout is returned by RunPrompts when a prompt times out with TimeoutCancel
+var ErrPromptTimeout = errors.New("prompt timed out")
+
+// errPromptIdle signals that a prompt went unanswered for the idle timeout
+var errPromptIdle = errors.New("prompt idle")
+
 // NewInlinePrompter creates a new inline prompter
 func NewInlinePrompter() (*InlinePrompter, error) {
 	promptConfig, err := config.LoadPromptConfiguration()
@@ -42,13 +106,131 @@ func NewInlinePrompter() (*InlinePrompter, error) {
 		config:     promptConfig,
 		userConfig: &config.UserConfiguration{},
 		reader:     bufio.NewReader(os.Stdin),
+		color:      true,
+		after:      time.After,
 	}, nil
 }
 
+// SetTimeout gives up on a prompt after it has been idle for d, either using
+// defaults for the remaining prompts or cancelling (0 = wait forever)
+func (ip *InlinePrompter) SetTimeout(d time.Duration, action TimeoutAction) {
+	ip.timeout = d
+	ip.timeoutAction = action
+}
+
+// SetTimer replaces the timer used for the idle timeout, for deterministic tests
+func (ip *InlinePrompter) SetTimer(after func(time.Duration) <-chan time.Time) {
+	if after == nil {
+		after = time.After
+	}
+	ip.after = after
+}
+
+// readLine reads one answer, giving up after the idle timeout. A timed-out
+// read stays pending in the background reader and is never lost.
+func (ip *InlinePrompter) readLine() (string, error) {
+	if ip.timeout <= 0 {
+		return ip.reader.ReadString('\n')
+	}
+
+	if ip.lines == nil {
+		ip.startReader()
+	}
+
+	select {
+	case result := <-ip.lines:
+		return result.line, result.err
+	case <-ip.after(ip.timeout):
+		return "", errPromptIdle
+	}
+}
+
+// startReader starts the single goroutine that feeds stdin lines to readLine
+func (ip *InlinePrompter) startReader() {
+	lines := make(chan readResult)
+	stop := make(chan struct{})
+	ip.lines = lines
+	ip.stopReader = stop
+
+	go func() {
+		for {
+			line, err := ip.reader.ReadString('\n')
+			select {
+			case lines <- readResult{line: line, err: err}:
+			case <-stop:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// closeReader stops the background reader. A read already blocked on stdin
+// can't be interrupted, but the goroutine exits as soon as it returns.
+func (ip *InlinePrompter) closeReader() {
+	if ip.stopReader == nil {
+		return
+	}
+	close(ip.stopReader)
+	ip.stopReader = nil
+	ip.lines = nil
+}
+
+// SetColorEnabled controls whether prompt responses are styled with ANSI colors
+func (ip *InlinePrompter) SetColorEnabled(enabled bool) {
+	ip.color = enabled
+}
+
+// SetNonInteractive marks stdin as not a terminal so that running out of input
+// falls back to defaults instead of failing
+func (ip *InlinePrompter) SetNonInteractive(nonInteractive bool) {
+	ip.nonInteractive = nonInteractive
+}
+
+// styleResponse applies the italic grey response style when colors are enabled
+func (ip *InlinePrompter) styleResponse(text string) string {
+	if !ip.color {
+		return text
+	}
+	return responseStyle + text + resetStyle
+}
+
 // RunPrompts executes all applicable prompts based on conditions
 func (ip *InlinePrompter) RunPrompts(devOnly bool, flags []string) (*config.UserConfiguration, error) {
-	// Initialize user config with defaults
-	ip.userConfig = &config.UserConfiguration{
+	ip.userConfig = DefaultUserConfiguration()
+	defer ip.closeReader()
+
+	// Process each prompt
+	for _, promptConfig := range ip.config.Prompts {
+		if promptConfig.ShouldTrigger(devOnly, flags) {
+			err := ip.askPrompt(&promptConfig)
+			if errors.Is(err, errInputExhausted) {
+				fmt.Println()
+				fmt.Fprintln(os.Stderr, "Warning: stdin is not a terminal and ran out of answers; using defaults for the remaining prompts (pass --defaults to skip prompting)")
+				break
+			}
+			if errors.Is(err, errPromptIdle) {
+				fmt.Println()
+				if ip.timeoutAction == TimeoutCancel {
+					return nil, fmt.Errorf("%w after %s of inactivity", ErrPromptTimeout, ip.timeout)
+				}
+				fmt.Fprintf(os.Stderr, "Warning: no answer after %s; using defaults for the remaining prompts\n", ip.timeout)
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ip.userConfig, nil
+}
+
+// DefaultUserConfiguration returns the configuration used when prompts are skipped
+func DefaultUserConfiguration() *config.UserConfiguration {
+	return &config.UserConfiguration{
 		ProjectName: "", // Will be set by caller
 		Template: config.TemplateConfig{
 			Type: config.TypeScript, // Default
@@ -71,18 +253,6 @@ func (ip *InlinePrompter) RunPrompts(devOnly bool, flags []string) (*config.User
 			Coverage:    true,
 		},
 	}
-
-	// Process each prompt
-	for _, promptConfig := range ip.config.Prompts {
-		if promptConfig.ShouldTrigger(devOnly, flags) {
-			err := ip.askPrompt(&promptConfig)
-			if err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	return ip.userConfig, nil
 }
 
 // askPrompt handles a single prompt interaction with enhanced formatting
@@ -92,8 +262,14 @@ func (ip *InlinePrompter) askPrompt(prompt *config.PromptConfig) error {
 		fmt.Printf("? %s ", prompt.Question)
 
 		// Read user input
-		input, err := ip.reader.ReadString('\n')
-		if err != nil {
+		input, err := ip.readLine()
+		if errors.Is(err, errPromptIdle) {
+			return err
+		}
+		if err != nil && (err != io.EOF || strings.TrimSpace(input) == "") {
+			if err == io.EOF && ip.nonInteractive {
+				return errInputExhausted
+			}
 			return fmt.Errorf("failed to read input: %w", err)
 		}
 
@@ -108,7 +284,7 @@ func (ip *InlinePrompter) askPrompt(prompt *config.PromptConfig) error {
 				validOptions = append(validOptions, option)
 			}
 			errorMsg := fmt.Sprintf("Invalid input. Valid options: %s", strings.Join(validOptions, ", "))
-			fmt.Printf("  └ %s%s%s\n", responseStyle, errorMsg, resetStyle)
+			fmt.Printf("  └ %s\n", ip.styleResponse(errorMsg))
 			continue
 		}
 
@@ -123,11 +299,11 @@ func (ip *InlinePrompter) askPrompt(prompt *config.PromptConfig) error {
 		if len(responseLines) > 0 {
 			for i, line := range responseLines {
 				if i == 0 {
-					fmt.Printf("  └ %s%s%s\n", responseStyle, line, resetStyle)
+					fmt.Printf("  └ %s\n", ip.styleResponse(line))
 				} else if i == len(responseLines)-1 {
-					fmt.Printf("  └ %s%s%s\n", responseStyle, line, resetStyle)
+					fmt.Printf("  └ %s\n", ip.styleResponse(line))
 				} else {
-					fmt.Printf("  ├ %s%s%s\n", responseStyle, line, resetStyle)
+					fmt.Printf("  ├ %s\n", ip.styleResponse(line))
 				}
 			}
 		}
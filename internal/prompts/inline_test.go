@@ -0,0 +1,176 @@
+package prompts
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+)
+
+// newTestPrompter builds a colorless prompter over the built-in prompts that
+// reads its answers from input instead of stdin
+func newTestPrompter(t *testing.T, input string) *InlinePrompter {
+	t.Helper()
+
+	promptConfig, err := config.LoadPromptConfiguration()
+	if err != nil {
+		t.Fatalf("LoadPromptConfiguration() error = %v", err)
+	}
+	return &InlinePrompter{
+		config:     promptConfig,
+		userConfig: &config.UserConfiguration{},
+		reader:     bufio.NewReader(strings.NewReader(input)),
+		after:      time.After,
+	}
+}
+
+func TestNonInteractiveStdinFallsBackToDefaults(t *testing.T) {
+	ip := newTestPrompter(t, "y\n")
+	ip.SetNonInteractive(true)
+
+	userConfig, err := ip.RunPrompts(true, nil)
+	if err != nil {
+		t.Fatalf("RunPrompts() error = %v, want the defaults fallback", err)
+	}
+
+	// The piped answer was applied...
+	if !userConfig.ProductionSetup.TrustBridge {
+		t.Error("piped production data answer was not applied")
+	}
+	// ...and the unanswered prompts kept their defaults
+	defaults := DefaultUserConfiguration()
+	if userConfig.Navigation != defaults.Navigation || userConfig.Template != defaults.Template {
+		t.Errorf("unanswered prompts changed the defaults: %+v", userConfig)
+	}
+}
+
+func TestInteractiveStdinEOFIsAnError(t *testing.T) {
+	ip := newTestPrompter(t, "")
+
+	if _, err := ip.RunPrompts(true, nil); err == nil {
+		t.Fatal("RunPrompts() error = nil, want a read failure on a closed terminal")
+	}
+}
+
+// manualTimer is an idle timer the test fires by hand; armed receives each
+// time a prompt starts waiting
+type manualTimer struct {
+	fire  chan time.Time
+	armed chan time.Duration
+}
+
+func newManualTimer() *manualTimer {
+	return &manualTimer{fire: make(chan time.Time), armed: make(chan time.Duration, 16)}
+}
+
+func (mt *manualTimer) after(d time.Duration) <-chan time.Time {
+	mt.armed <- d
+	return mt.fire
+}
+
+// waitArmed blocks until a prompt has started waiting on the timer
+func (mt *manualTimer) waitArmed(t *testing.T) {
+	t.Helper()
+	select {
+	case <-mt.armed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("prompt never started waiting on the idle timer")
+	}
+}
+
+// runPromptsAsync runs RunPrompts in the background and returns its result channel
+func runPromptsAsync(ip *InlinePrompter) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := ip.RunPrompts(true, nil)
+		done <- err
+	}()
+	return done
+}
+
+// waitGoroutines waits for the goroutine count to drop back to want
+func waitGoroutines(t *testing.T, want int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines still running, want %d: the stdin reader leaked", runtime.NumGoroutine(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPromptTimeoutFiresChosenAction(t *testing.T) {
+	tests := []struct {
+		action  TimeoutAction
+		wantErr error
+	}{
+		{action: TimeoutUseDefaults},
+		{action: TimeoutCancel, wantErr: ErrPromptTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action.String(), func(t *testing.T) {
+			baseline := runtime.NumGoroutine()
+
+			stdin, input := io.Pipe()
+			ip := newTestPrompter(t, "")
+			ip.reader = bufio.NewReader(stdin)
+			timer := newManualTimer()
+			ip.SetTimer(timer.after)
+			ip.SetTimeout(time.Minute, tt.action)
+
+			done := runPromptsAsync(ip)
+
+			// Answering re-arms the timer for the next prompt
+			timer.waitArmed(t)
+			if _, err := io.WriteString(input, "y\n"); err != nil {
+				t.Fatalf("answering first prompt: %v", err)
+			}
+			timer.waitArmed(t)
+			timer.fire <- time.Now()
+
+			err := <-done
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RunPrompts() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !ip.userConfig.ProductionSetup.TrustBridge {
+				t.Error("answer given before the timeout was lost")
+			}
+
+			// The reader is blocked on stdin; it must exit once that read returns
+			input.Close()
+			waitGoroutines(t, baseline)
+		})
+	}
+}
+
+func TestPromptTimeoutReaderStopsAfterLateAnswer(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+
+	stdin, input := io.Pipe()
+	ip := newTestPrompter(t, "")
+	ip.reader = bufio.NewReader(stdin)
+	timer := newManualTimer()
+	ip.SetTimer(timer.after)
+	ip.SetTimeout(time.Minute, TimeoutUseDefaults)
+
+	done := runPromptsAsync(ip)
+	timer.waitArmed(t)
+	timer.fire <- time.Now()
+	if err := <-done; err != nil {
+		t.Fatalf("RunPrompts() error = %v", err)
+	}
+
+	// A line typed after the prompts gave up is dropped, not sent to nobody forever
+	if _, err := io.WriteString(input, "y\n"); err != nil {
+		t.Fatalf("late answer: %v", err)
+	}
+	waitGoroutines(t, baseline)
+	input.Close()
+}
@@ -2,32 +2,33 @@ package errors
 
 import (
 	"fmt"
+	"sort"
 )
 
 // ErrorScenario represents a simulated error with recovery information
 type ErrorScenario struct {
-	Code        string
-	Title       string
-	Description string
-	Causes      []string
-	Actions     []Action
-	QuickFix    *QuickFix
-	HelpCommand string
-	Severity    Severity
+	Code        string    `json:"code"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Causes      []string  `json:"causes"`
+	Actions     []Action  `json:"actions"`
+	QuickFix    *QuickFix `json:"quick_fix,omitempty"`
+	HelpCommand string    `json:"help_command,omitempty"`
+	Severity    Severity  `json:"severity"`
 }
 
 // Action represents a suggested action for error recovery
 type Action struct {
-	Description string
-	Command     string
-	AutoFix     bool
+	Description string `json:"description"`
+	Command     string `json:"command"`
+	AutoFix     bool   `json:"auto_fix"`
 }
 
 // QuickFix represents an automated fix option
 type QuickFix struct {
-	Description string
-	Command     string
-	Confirmation bool // Whether to ask for user confirmation
+	Description string `json:"description"`
+	Command     string `json:"command"`
+	Confirmation bool  `json:"confirmation"` // Whether to ask for user confirmation
 }
 
 // Severity represents the severity level of an error
@@ -40,6 +41,38 @@ const (
 	SeverityCritical
 )
 
+// String returns the severity label
+func (s Severity) String() string {
+	switch s {
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText serializes the severity as its label
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses a severity label written by MarshalText
+func (s *Severity) UnmarshalText(text []byte) error {
+	for candidate := SeverityLow; candidate <= SeverityCritical; candidate++ {
+		if candidate.String() == string(text) {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown severity: %s", text)
+}
+
 // Common error scenarios for the create command
 var CreateErrorScenarios = map[string]*ErrorScenario{
 	"CONFIG_INVALID": {
@@ -229,6 +262,21 @@ func GetErrorScenario(code string) *ErrorScenario {
 	return CreateErrorScenarios[code]
 }
 
+// ListErrorScenarios returns the catalog sorted by code for stable output
+func ListErrorScenarios() []*ErrorScenario {
+	codes := make([]string, 0, len(CreateErrorScenarios))
+	for code := range CreateErrorScenarios {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	scenarios := make([]*ErrorScenario, 0, len(codes))
+	for _, code := range codes {
+		scenarios = append(scenarios, CreateErrorScenarios[code])
+	}
+	return scenarios
+}
+
 // GetRandomErrorScenario returns a random error scenario for simulation
 func GetRandomErrorScenario() *ErrorScenario {
 	scenarioKeys := []string{
@@ -245,39 +293,6 @@ func GetRandomErrorScenario() *ErrorScenario {
 	return CreateErrorScenarios["NETWORK_ERROR"]
 }
 
-// FormatErrorMessage formats an error scenario into a user-friendly message
-func FormatErrorMessage(scenario *ErrorScenario) string {
-	msg := fmt.Sprintf("❌ Error: %s\n\n", scenario.Title)
-	msg += fmt.Sprintf("🔍 Problem: %s\n", scenario.Description)
-
-	if len(scenario.Causes) > 0 {
-		msg += "📋 Likely Causes:\n"
-		for _, cause := range scenario.Causes {
-			msg += fmt.Sprintf("   • %s\n", cause)
-		}
-		msg += "\n"
-	}
-
-	if len(scenario.Actions) > 0 {
-		msg += "🛠️  Suggested Actions:\n"
-		for i, action := range scenario.Actions {
-			msg += fmt.Sprintf("   %d. %s: %s\n", i+1, action.Description, action.Command)
-		}
-		msg += "\n"
-	}
-
-	if scenario.QuickFix != nil {
-		msg += fmt.Sprintf("⚡ Quick Fix Available:\n   Run '%s' to %s\n\n",
-			scenario.QuickFix.Command, scenario.QuickFix.Description)
-	}
-
-	if scenario.HelpCommand != "" {
-		msg += fmt.Sprintf("❓ More Help: %s\n", scenario.HelpCommand)
-	}
-
-	return msg
-}
-
 // ShouldSimulateError determines if an error should be simulated based on error rate
 func ShouldSimulateError(errorRate float64) bool {
 	// For demo purposes, simulate errors at a lower rate than specified
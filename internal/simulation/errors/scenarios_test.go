@@ -0,0 +1,56 @@
+package errors
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestErrorCatalogSerializesActionsAndQuickFix(t *testing.T) {
+	scenarios := ListErrorScenarios()
+	if len(scenarios) != len(CreateErrorScenarios) {
+		t.Fatalf("ListErrorScenarios() returned %d scenarios, want %d", len(scenarios), len(CreateErrorScenarios))
+	}
+
+	data, err := json.Marshal(scenarios)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var decoded []ErrorScenario
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	for i, got := range decoded {
+		want := scenarios[i]
+		if got.Code != want.Code || got.Title != want.Title || got.Severity != want.Severity {
+			t.Errorf("scenario %d = %s/%q/%s, want %s/%q/%s",
+				i, got.Code, got.Title, got.Severity, want.Code, want.Title, want.Severity)
+		}
+		if len(got.Causes) == 0 || len(got.Causes) != len(want.Causes) {
+			t.Errorf("%s: %d causes serialized, want %d", want.Code, len(got.Causes), len(want.Causes))
+		}
+		if len(got.Actions) == 0 || len(got.Actions) != len(want.Actions) {
+			t.Errorf("%s: %d actions serialized, want %d", want.Code, len(got.Actions), len(want.Actions))
+		}
+		for j, action := range got.Actions {
+			if action != want.Actions[j] {
+				t.Errorf("%s: action %d = %+v, want %+v", want.Code, j, action, want.Actions[j])
+			}
+		}
+		if got.QuickFix == nil || want.QuickFix == nil {
+			t.Errorf("%s: quick fix missing", want.Code)
+		} else if *got.QuickFix != *want.QuickFix {
+			t.Errorf("%s: quick fix = %+v, want %+v", want.Code, *got.QuickFix, *want.QuickFix)
+		}
+	}
+}
+
+func TestListErrorScenariosSortedByCode(t *testing.T) {
+	scenarios := ListErrorScenarios()
+	for i := 1; i < len(scenarios); i++ {
+		if scenarios[i-1].Code >= scenarios[i].Code {
+			t.Errorf("scenarios out of order: %s before %s", scenarios[i-1].Code, scenarios[i].Code)
+		}
+	}
+}
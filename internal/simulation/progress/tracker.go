@@ -1,6 +1,10 @@
 package progress
 
 import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -8,10 +12,68 @@ import (
 type Step struct {
 	Name        string
 	Message     string
+	Messages    []string // Optional messages cycled evenly across the step's progress
 	Duration    time.Duration
 	ErrorRate   float64 // 0.0 to 1.0, probability of this step failing
 	CanRetry    bool
 	Description string
+	Tags        []string // Categories such as "network", "filesystem", "build"
+	Executor    StepExecutor // Optional real work run once the simulated delay has elapsed
+}
+
+// ProgressAt returns how far through the step elapsed is, clamped to 0.0-1.0.
+// A zero-duration step is complete as soon as it starts.
+func (s *Step) ProgressAt(elapsed time.Duration) float64 {
+	if s.Duration <= 0 {
+		return 1.0
+	}
+
+	progress := float64(elapsed) / float64(s.Duration)
+	if progress > 1.0 {
+		return 1.0
+	}
+	if progress < 0 {
+		return 0
+	}
+	return progress
+}
+
+// StepExecutor performs the real work behind a step
+type StepExecutor interface {
+	Execute(ctx context.Context) error
+}
+
+// StepExecutorFunc adapts a plain function to a StepExecutor
+type StepExecutorFunc func(ctx context.Context) error
+
+// Execute implements StepExecutor
+func (f StepExecutorFunc) Execute(ctx context.Context) error {
+	return f(ctx)
+}
+
+// noopExecutor is used for steps without an executor: the simulated delay is the whole step
+type noopExecutor struct{}
+
+// Execute implements StepExecutor
+func (noopExecutor) Execute(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// MessageAt returns the message for the given progress (0.0 to 1.0), splitting
+// Messages into equal ranges and falling back to Message when none are set
+func (s *Step) MessageAt(progress float64) string {
+	if len(s.Messages) == 0 {
+		return s.Message
+	}
+
+	index := int(progress * float64(len(s.Messages)))
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(s.Messages) {
+		index = len(s.Messages) - 1
+	}
+	return s.Messages[index]
 }
 
 // Tracker manages the progress simulation
@@ -23,6 +85,29 @@ type Tracker struct {
 	completed   bool
 	failed      bool
 	lastError   error
+	stepErr     error      // Failure recorded against the current step
+	random      *rand.Rand // Drives natural step failures; seed with SetSeed
+	now         func() time.Time // Injectable clock; defaults to time.Now
+	mu          sync.RWMutex     // Guards every field so Status is a consistent snapshot
+
+	// Step-completion hooks, run off the caller's goroutine in step order
+	onStepComplete []func(StepResult)
+	dispatchDone   chan struct{} // Closed when the latest dispatch finishes
+}
+
+// Step result statuses reported to OnStepComplete callbacks
+const (
+	StepSucceeded = "success"
+	StepFailed    = "failed"
+)
+
+// StepResult describes a finished step for OnStepComplete callbacks
+type StepResult struct {
+	Index    int
+	Name     string
+	Status   string // StepSucceeded or StepFailed
+	Duration time.Duration
+	Error    error // Set when Status is StepFailed
 }
 
 // NewTracker creates a new progress tracker with predefined steps
@@ -34,6 +119,8 @@ func NewTracker(steps []Step) *Tracker {
 		stepStart:   time.Now(),
 		completed:   false,
 		failed:      false,
+		random:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:         time.Now,
 	}
 }
 
@@ -47,6 +134,7 @@ func NewCreateTracker(devOnly bool) *Tracker {
 			ErrorRate:   0.05, // 5% chance of config error
 			CanRetry:    true,
 			Description: "Validates project name, checks for conflicts, verifies system requirements",
+			Tags:        []string{"config", "filesystem"},
 		},
 		{
 			Name:        "Setting up environment",
@@ -55,14 +143,21 @@ func NewCreateTracker(devOnly bool) *Tracker {
 			ErrorRate:   0.10, // 10% chance of environment error
 			CanRetry:    true,
 			Description: "Creates project directory, sets up git repository, configures development tools",
+			Tags:        []string{"filesystem", "config"},
 		},
 		{
 			Name:        "Installing dependencies",
 			Message:     "📦 Installing React and core dependencies...",
+			Messages: []string{
+				"📦 Resolving dependency tree...",
+				"📦 Downloading React and core dependencies...",
+				"📦 Linking packages...",
+			},
 			Duration:    time.Millisecond * 3000,
 			ErrorRate:   0.15, // 15% chance of network/install error
 			CanRetry:    true,
 			Description: "Downloads and installs React, TypeScript, testing libraries, and build tools",
+			Tags:        []string{"network", "dependencies"},
 		},
 		{
 			Name:        "Generating project structure",
@@ -71,6 +166,7 @@ func NewCreateTracker(devOnly bool) *Tracker {
 			ErrorRate:   0.02, // 2% chance of filesystem error
 			CanRetry:    true,
 			Description: "Generates source code structure, configuration files, and example components",
+			Tags:        []string{"filesystem"},
 		},
 	}
 
@@ -83,6 +179,7 @@ func NewCreateTracker(devOnly bool) *Tracker {
 			ErrorRate:   0.08, // 8% chance of deployment config error
 			CanRetry:    true,
 			Description: "Configures build scripts, environment variables, and deployment targets",
+			Tags:        []string{"build", "config"},
 		})
 	}
 
@@ -90,10 +187,15 @@ func NewCreateTracker(devOnly bool) *Tracker {
 	steps = append(steps, Step{
 		Name:        "Installing Testing Frameworks",
 		Message:     "🧪 Setting up testing infrastructure...",
+		Messages: []string{
+			"🧪 Installing test runners...",
+			"🧪 Configuring coverage reporting...",
+		},
 		Duration:    time.Millisecond * 1800,
 		ErrorRate:   0.05, // 5% chance of testing setup error
 		CanRetry:    true,
 		Description: "Installs and configures Vitest, testing utilities, and coverage tools",
+		Tags:        []string{"network", "dependencies", "testing"},
 	})
 
 	// Add documentation generation step
@@ -104,6 +206,7 @@ func NewCreateTracker(devOnly bool) *Tracker {
 		ErrorRate:   0.02, // 2% chance of documentation error
 		CanRetry:    true,
 		Description: "Generates README, API docs, and component documentation",
+		Tags:        []string{"filesystem", "docs"},
 	})
 
 	// Final step
@@ -114,6 +217,7 @@ func NewCreateTracker(devOnly bool) *Tracker {
 		ErrorRate:   0.0, // No errors on final step
 		CanRetry:    false,
 		Description: "Completes setup, runs initial health checks, and prepares development server",
+		Tags:        []string{"build"},
 	})
 
 	return NewTracker(steps)
@@ -121,31 +225,153 @@ func NewCreateTracker(devOnly bool) *Tracker {
 
 // Start begins the progress simulation
 func (t *Tracker) Start() {
-	t.startTime = time.Now()
-	t.stepStart = time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.startTime = t.now()
+	t.stepStart = t.now()
 	t.currentStep = 0
 }
 
+// SetClock replaces the tracker's time source, e.g. with a fixed clock for snapshots
+func (t *Tracker) SetClock(now func() time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if now == nil {
+		now = time.Now
+	}
+	t.now = now
+}
+
+// SetSeed reseeds the tracker's RNG so natural step failures are reproducible (0 = random)
+func (t *Tracker) SetSeed(seed int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if seed == 0 {
+		return
+	}
+	t.random = rand.New(rand.NewSource(seed))
+}
+
+// ShouldFail rolls the step's natural error rate against the tracker's RNG
+func (t *Tracker) ShouldFail(step *Step) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if step == nil || step.ErrorRate <= 0 {
+		return false
+	}
+	return t.random.Float64() < step.ErrorRate
+}
+
+// ExecuteStep runs the step's executor, defaulting to a no-op, and records
+// any error it returns as the tracker's last error
+func (t *Tracker) ExecuteStep(ctx context.Context, index int) error {
+	t.mu.RLock()
+	if index < 0 || index >= len(t.steps) {
+		t.mu.RUnlock()
+		return fmt.Errorf("step index %d out of range (0-%d)", index, len(t.steps)-1)
+	}
+	name := t.steps[index].Name
+	executor := t.steps[index].Executor
+	t.mu.RUnlock()
+
+	if executor == nil {
+		executor = noopExecutor{}
+	}
+
+	// The executor runs unlocked so slow work doesn't block observers
+	if err := executor.Execute(ctx); err != nil {
+		wrapped := fmt.Errorf("step %q failed: %w", name, err)
+		t.mu.Lock()
+		t.lastError = wrapped
+		if index == t.currentStep && t.stepErr == nil {
+			t.stepErr = wrapped
+		}
+		t.mu.Unlock()
+		return wrapped
+	}
+	return nil
+}
+
+// TrackerStatus is a consistent snapshot of the tracker's state
+type TrackerStatus struct {
+	CurrentStep int     // 0-based; equals TotalSteps once completed
+	TotalSteps  int
+	StepName    string  // Empty once completed
+	Progress    float64 // Overall progress, 0.0 to 1.0
+	Completed   bool
+	Failed      bool
+	LastError   error
+}
+
+// Status returns the tracker's state in one call, so observers never see a
+// step change half-way through reading it
+func (t *Tracker) Status() TrackerStatus {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	status := TrackerStatus{
+		CurrentStep: t.currentStep,
+		TotalSteps:  len(t.steps),
+		Progress:    t.progress(),
+		Completed:   t.completed,
+		Failed:      t.failed,
+		LastError:   t.lastError,
+	}
+	if t.currentStep < len(t.steps) {
+		status.StepName = t.steps[t.currentStep].Name
+	}
+	return status
+}
+
 // CurrentStep returns the current step number (0-based)
 func (t *Tracker) CurrentStep() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.currentStep
 }
 
 // TotalSteps returns the total number of steps
 func (t *Tracker) TotalSteps() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return len(t.steps)
 }
 
 // GetStep returns the step at the given index
 func (t *Tracker) GetStep(index int) *Step {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	if index < 0 || index >= len(t.steps) {
 		return nil
 	}
 	return &t.steps[index]
 }
 
+// GetStepByName returns the first step with the given name
+func (t *Tracker) GetStepByName(name string) *Step {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for i := range t.steps {
+		if t.steps[i].Name == name {
+			return &t.steps[i]
+		}
+	}
+	return nil
+}
+
 // CurrentStepInfo returns information about the current step
 func (t *Tracker) CurrentStepInfo() *Step {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	if t.currentStep >= len(t.steps) {
 		return nil
 	}
@@ -154,6 +380,14 @@ func (t *Tracker) CurrentStepInfo() *Step {
 
 // Progress returns the current progress as a percentage (0.0 to 1.0)
 func (t *Tracker) Progress() float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.progress()
+}
+
+// progress computes Progress (caller holds the lock)
+func (t *Tracker) progress() float64 {
 	if len(t.steps) == 0 {
 		return 1.0
 	}
@@ -163,11 +397,7 @@ func (t *Tracker) Progress() float64 {
 	// Add partial progress for current step based on elapsed time
 	if t.currentStep < len(t.steps) && !t.completed && !t.failed {
 		currentStep := &t.steps[t.currentStep]
-		elapsed := time.Since(t.stepStart)
-		stepPartial := float64(elapsed) / float64(currentStep.Duration)
-		if stepPartial > 1.0 {
-			stepPartial = 1.0
-		}
+		stepPartial := currentStep.ProgressAt(t.now().Sub(t.stepStart))
 		stepProgress += stepPartial / float64(len(t.steps))
 	}
 
@@ -180,23 +410,124 @@ func (t *Tracker) Progress() float64 {
 
 // IsStepReady returns true if the current step should complete
 func (t *Tracker) IsStepReady() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	if t.currentStep >= len(t.steps) || t.completed || t.failed {
 		return false
 	}
 
 	currentStep := &t.steps[t.currentStep]
-	return time.Since(t.stepStart) >= currentStep.Duration
+	return t.now().Sub(t.stepStart) >= currentStep.Duration
+}
+
+// OnStepComplete registers fn to run each time NextStep finishes a step or
+// Fail ends the run on one. Callbacks run on their own goroutine, one step at
+// a time in step order, so a slow callback never blocks the update loop.
+func (t *Tracker) OnStepComplete(fn func(StepResult)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if fn != nil {
+		t.onStepComplete = append(t.onStepComplete, fn)
+	}
+}
+
+// FailStep records err against the current step, so the step is reported as
+// failed when a keep-going run moves past it
+func (t *Tracker) FailStep(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err == nil || t.currentStep >= len(t.steps) {
+		return
+	}
+	if t.stepErr == nil {
+		t.stepErr = err
+	}
+	t.lastError = err
+}
+
+// Fail ends the run on the current step, reporting it as failed to the
+// OnStepComplete callbacks. Later calls are ignored, so a step that fails the
+// run is reported once.
+func (t *Tracker) Fail(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.failed || t.completed || t.currentStep >= len(t.steps) {
+		return
+	}
+	if err == nil {
+		err = t.stepErr
+	}
+	if err == nil {
+		err = fmt.Errorf("step %q failed", t.steps[t.currentStep].Name)
+	}
+
+	t.failed = true
+	t.lastError = err
+	t.stepErr = nil
+	t.dispatchStepComplete(StepResult{
+		Index:    t.currentStep,
+		Name:     t.steps[t.currentStep].Name,
+		Status:   StepFailed,
+		Duration: t.now().Sub(t.stepStart),
+		Error:    err,
+	})
+}
+
+// dispatchStepComplete queues result for the registered callbacks (caller
+// holds the lock)
+func (t *Tracker) dispatchStepComplete(result StepResult) {
+	if len(t.onStepComplete) == 0 {
+		return
+	}
+
+	callbacks := append(([]func(StepResult))(nil), t.onStepComplete...)
+	previous := t.dispatchDone
+	done := make(chan struct{})
+	t.dispatchDone = done
+
+	go func() {
+		defer close(done)
+		if previous != nil {
+			<-previous
+		}
+		for _, fn := range callbacks {
+			fn(result)
+		}
+	}()
 }
 
 // NextStep advances to the next step
 func (t *Tracker) NextStep() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.failed {
+		return false
+	}
 	if t.currentStep >= len(t.steps) {
 		t.completed = true
 		return false
 	}
 
+	result := StepResult{
+		Index:    t.currentStep,
+		Name:     t.steps[t.currentStep].Name,
+		Status:   StepSucceeded,
+		Duration: t.now().Sub(t.stepStart),
+	}
+	if t.stepErr != nil {
+		result.Status = StepFailed
+		result.Error = t.stepErr
+	}
+	t.stepErr = nil
+	t.dispatchStepComplete(result)
+
 	t.currentStep++
-	t.stepStart = time.Now()
+	t.stepStart = t.now()
 
 	if t.currentStep >= len(t.steps) {
 		t.completed = true
@@ -208,21 +539,33 @@ func (t *Tracker) NextStep() bool {
 
 // IsCompleted returns true if all steps are finished
 func (t *Tracker) IsCompleted() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.completed
 }
 
 // IsFailed returns true if the simulation failed
 func (t *Tracker) IsFailed() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.failed
 }
 
 // GetError returns the last error that occurred
 func (t *Tracker) GetError() error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.lastError
 }
 
 // EstimatedTimeRemaining calculates the estimated time to completion
 func (t *Tracker) EstimatedTimeRemaining() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	if t.completed || t.failed {
 		return 0
 	}
@@ -234,7 +577,7 @@ func (t *Tracker) EstimatedTimeRemaining() time.Duration {
 
 	// Subtract elapsed time from current step
 	if t.currentStep < len(t.steps) {
-		elapsed := time.Since(t.stepStart)
+		elapsed := t.now().Sub(t.stepStart)
 		stepDuration := t.steps[t.currentStep].Duration
 		if elapsed < stepDuration {
 			remaining -= elapsed
@@ -244,27 +587,73 @@ func (t *Tracker) EstimatedTimeRemaining() time.Duration {
 	return remaining
 }
 
+// SetStepDuration changes a pending or running step's duration, e.g. to linger
+// on a step during a demo. Completed steps can't be changed; a zero duration
+// makes the step complete immediately.
+func (t *Tracker) SetStepDuration(index int, d time.Duration) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if index < 0 || index >= len(t.steps) {
+		return fmt.Errorf("step index %d out of range (0-%d)", index, len(t.steps)-1)
+	}
+	if d < 0 {
+		return fmt.Errorf("step duration must not be negative, got %s", d)
+	}
+	if t.completed || index < t.currentStep {
+		return fmt.Errorf("step %q has already completed", t.steps[index].Name)
+	}
+
+	t.steps[index].Duration = d
+	return nil
+}
+
+// TotalEstimatedDuration returns the sum of every step's configured duration
+func (t *Tracker) TotalEstimatedDuration() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var total time.Duration
+	for _, step := range t.steps {
+		total += step.Duration
+	}
+	return total
+}
+
 // TotalElapsed returns the total time elapsed since start
 func (t *Tracker) TotalElapsed() time.Duration {
-	return time.Since(t.startTime)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.now().Sub(t.startTime)
 }
 
 // GetStepStart returns the start time of the current step
 func (t *Tracker) GetStepStart() time.Time {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return t.stepStart
 }
 
 // GetSteps returns a copy of all steps
 func (t *Tracker) GetSteps() []Step {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	return append([]Step(nil), t.steps...)
 }
 
 // Reset resets the tracker to the beginning
 func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	t.currentStep = 0
-	t.startTime = time.Now()
-	t.stepStart = time.Now()
+	t.startTime = t.now()
+	t.stepStart = t.now()
 	t.completed = false
 	t.failed = false
 	t.lastError = nil
+	t.stepErr = nil
 }
\ No newline at end of file
@@ -0,0 +1,399 @@
+package progress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultCreateStepsAreTagged(t *testing.T) {
+	for _, devOnly := range []bool{true, false} {
+		for _, step := range NewCreateTracker(devOnly).GetSteps() {
+			if len(step.Tags) == 0 {
+				t.Errorf("devOnly=%t: step %q has no tags", devOnly, step.Name)
+			}
+		}
+	}
+}
+
+func TestStepMessageAt(t *testing.T) {
+	step := &Step{
+		Message:  "Installing dependencies...",
+		Messages: []string{"Resolving...", "Downloading...", "Linking..."},
+	}
+
+	tests := []struct {
+		progress float64
+		want     string
+	}{
+		{-0.1, "Resolving..."},
+		{0, "Resolving..."},
+		{0.33, "Resolving..."},
+		{0.34, "Downloading..."},
+		{0.66, "Downloading..."},
+		{0.67, "Linking..."},
+		{1.0, "Linking..."},
+		{1.5, "Linking..."},
+	}
+	for _, tt := range tests {
+		if got := step.MessageAt(tt.progress); got != tt.want {
+			t.Errorf("MessageAt(%v) = %q, want %q", tt.progress, got, tt.want)
+		}
+	}
+
+	single := &Step{Message: "Finalizing..."}
+	if got := single.MessageAt(0.5); got != "Finalizing..." {
+		t.Errorf("MessageAt() without Messages = %q, want the static Message", got)
+	}
+}
+
+func TestTotalEstimatedDuration(t *testing.T) {
+	tracker := NewTracker([]Step{
+		{Name: "one", Duration: 2 * time.Second},
+		{Name: "two", Duration: 500 * time.Millisecond},
+		{Name: "three", Duration: 0},
+	})
+	if got, want := tracker.TotalEstimatedDuration(), 2500*time.Millisecond; got != want {
+		t.Errorf("TotalEstimatedDuration() = %s, want %s", got, want)
+	}
+
+	if err := tracker.SetStepDuration(2, time.Second); err != nil {
+		t.Fatalf("SetStepDuration() error = %v", err)
+	}
+	if got, want := tracker.TotalEstimatedDuration(), 3500*time.Millisecond; got != want {
+		t.Errorf("TotalEstimatedDuration() after SetStepDuration = %s, want %s", got, want)
+	}
+
+	create := NewCreateTracker(false)
+	var sum time.Duration
+	for _, step := range create.GetSteps() {
+		sum += step.Duration
+	}
+	if got := create.TotalEstimatedDuration(); got != sum || got == 0 {
+		t.Errorf("create TotalEstimatedDuration() = %s, want the step sum %s", got, sum)
+	}
+}
+
+// newClockedTracker returns a started tracker driven by a fake clock
+func newClockedTracker(steps []Step) (*Tracker, *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := NewTracker(steps)
+	tracker.SetClock(func() time.Time { return now })
+	tracker.Start()
+	return tracker, &now
+}
+
+func TestSetStepDurationStretchesRunningStep(t *testing.T) {
+	tracker, now := newClockedTracker([]Step{
+		{Name: "install", Duration: 2 * time.Second},
+		{Name: "finish", Duration: time.Second},
+	})
+
+	*now = now.Add(2 * time.Second)
+	if !tracker.IsStepReady() {
+		t.Fatal("IsStepReady() = false once the original duration has elapsed")
+	}
+
+	if err := tracker.SetStepDuration(0, 5*time.Second); err != nil {
+		t.Fatalf("SetStepDuration() error = %v", err)
+	}
+	if tracker.IsStepReady() {
+		t.Error("IsStepReady() = true, want the stretched step to keep running")
+	}
+	if got, want := tracker.EstimatedTimeRemaining(), 4*time.Second; got != want {
+		t.Errorf("EstimatedTimeRemaining() = %s, want %s", got, want)
+	}
+
+	*now = now.Add(3 * time.Second)
+	if !tracker.IsStepReady() {
+		t.Error("IsStepReady() = false once the stretched duration has elapsed")
+	}
+}
+
+func TestSetStepDurationRejectsInvalidChanges(t *testing.T) {
+	tracker, _ := newClockedTracker([]Step{
+		{Name: "one", Duration: time.Second},
+		{Name: "two", Duration: time.Second},
+	})
+	tracker.NextStep()
+
+	tests := []struct {
+		name  string
+		index int
+		d     time.Duration
+	}{
+		{name: "completed step", index: 0, d: time.Second},
+		{name: "out of range", index: 2, d: time.Second},
+		{name: "negative duration", index: 1, d: -time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tracker.SetStepDuration(tt.index, tt.d); err == nil {
+				t.Errorf("SetStepDuration(%d, %s) error = nil, want a rejection", tt.index, tt.d)
+			}
+		})
+	}
+}
+
+func TestFailingExecutorFailsStep(t *testing.T) {
+	errScaffold := errors.New("scaffold: permission denied")
+	tracker, _ := newClockedTracker([]Step{
+		{Name: "Generating project structure", Executor: StepExecutorFunc(func(context.Context) error { return errScaffold })},
+		{Name: "Finalizing"},
+	})
+
+	results := make(chan StepResult, 1)
+	tracker.OnStepComplete(func(result StepResult) { results <- result })
+
+	err := tracker.ExecuteStep(context.Background(), 0)
+	if !errors.Is(err, errScaffold) {
+		t.Fatalf("ExecuteStep() error = %v, want it to wrap the executor's error", err)
+	}
+	if !strings.Contains(err.Error(), "Generating project structure") {
+		t.Errorf("ExecuteStep() error = %q, want it to name the step", err)
+	}
+	if got := tracker.GetError(); !errors.Is(got, errScaffold) {
+		t.Errorf("GetError() = %v, want the executor's error", got)
+	}
+
+	tracker.NextStep()
+	select {
+	case result := <-results:
+		if result.Status != StepFailed || !errors.Is(result.Error, errScaffold) {
+			t.Errorf("step result = %+v, want failed with the executor's error", result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStepComplete never reported the failed step")
+	}
+
+	if err := tracker.ExecuteStep(context.Background(), 1); err != nil {
+		t.Errorf("ExecuteStep() without an executor = %v, want the no-op default", err)
+	}
+}
+
+func TestZeroDurationStepCompletesCleanly(t *testing.T) {
+	tracker, now := newClockedTracker([]Step{
+		{Name: "instant", Duration: 0},
+		{Name: "timed", Duration: 2 * time.Second},
+	})
+
+	assertFinite := func(when string) {
+		t.Helper()
+		if p := tracker.Progress(); math.IsNaN(p) || math.IsInf(p, 0) || p < 0 || p > 1 {
+			t.Errorf("%s: Progress() = %v, want a finite value in 0-1", when, p)
+		}
+		if eta := tracker.EstimatedTimeRemaining(); eta < 0 {
+			t.Errorf("%s: EstimatedTimeRemaining() = %s, want non-negative", when, eta)
+		}
+	}
+
+	assertFinite("zero-duration step running")
+	if !tracker.IsStepReady() {
+		t.Error("IsStepReady() = false for a zero-duration step")
+	}
+	if got := tracker.CurrentStepInfo().ProgressAt(0); got != 1.0 {
+		t.Errorf("ProgressAt(0) = %v for a zero-duration step, want 1.0", got)
+	}
+	if got, want := tracker.Progress(), 0.5; got != want {
+		t.Errorf("Progress() = %v, want %v with the instant step done", got, want)
+	}
+
+	tracker.NextStep()
+	*now = now.Add(time.Second)
+	assertFinite("timed step running")
+
+	*now = now.Add(time.Second)
+	tracker.NextStep()
+	if !tracker.IsCompleted() {
+		t.Error("tracker did not complete")
+	}
+	assertFinite("completed")
+}
+
+func TestSetStepDurationZeroCompletesRunningStep(t *testing.T) {
+	tracker, _ := newClockedTracker([]Step{{Name: "linger", Duration: time.Minute}})
+
+	if err := tracker.SetStepDuration(0, 0); err != nil {
+		t.Fatalf("SetStepDuration(0, 0) error = %v", err)
+	}
+	if !tracker.IsStepReady() {
+		t.Error("IsStepReady() = false after shortening the step to zero")
+	}
+	if got := tracker.Progress(); got != 1.0 {
+		t.Errorf("Progress() = %v, want 1.0", got)
+	}
+}
+
+// checkStatusConsistent asserts the invariants that hold within one snapshot
+func checkStatusConsistent(t *testing.T, status TrackerStatus) {
+	t.Helper()
+
+	if status.CurrentStep < 0 || status.CurrentStep > status.TotalSteps {
+		t.Errorf("CurrentStep %d outside 0-%d", status.CurrentStep, status.TotalSteps)
+	}
+	if status.Progress < 0 || status.Progress > 1 {
+		t.Errorf("Progress %v outside 0.0-1.0", status.Progress)
+	}
+	if done := float64(status.CurrentStep) / float64(status.TotalSteps); status.Progress < done {
+		t.Errorf("Progress %v is behind the %d completed step(s)", status.Progress, status.CurrentStep)
+	}
+	if status.Completed != (status.CurrentStep == status.TotalSteps) {
+		t.Errorf("Completed = %t at step %d of %d", status.Completed, status.CurrentStep, status.TotalSteps)
+	}
+	if status.Completed && (status.Progress != 1 || status.StepName != "") {
+		t.Errorf("completed snapshot = %+v, want full progress and no step name", status)
+	}
+	if !status.Completed && status.StepName == "" {
+		t.Errorf("running snapshot at step %d has no step name", status.CurrentStep)
+	}
+}
+
+func TestStatusSnapshotIsConsistent(t *testing.T) {
+	steps := []Step{
+		{Name: "scaffold", Duration: 2 * time.Second},
+		{Name: "install", Duration: 2 * time.Second},
+		{Name: "finish", Duration: 2 * time.Second},
+	}
+	tracker, now := newClockedTracker(steps)
+
+	status := tracker.Status()
+	checkStatusConsistent(t, status)
+	if status.CurrentStep != 0 || status.StepName != "scaffold" || status.Progress != 0 {
+		t.Errorf("initial Status() = %+v, want step 0 (scaffold) with no progress", status)
+	}
+
+	*now = now.Add(time.Second)
+	errInstall := errors.New("registry timeout")
+	for i, step := range steps {
+		status := tracker.Status()
+		checkStatusConsistent(t, status)
+		if status.CurrentStep != i || status.StepName != step.Name {
+			t.Errorf("Status() at step %d = %+v, want %q", i, status, step.Name)
+		}
+		if status.Progress != tracker.Progress() {
+			t.Errorf("Status().Progress = %v, want Progress() = %v", status.Progress, tracker.Progress())
+		}
+		if i == 1 {
+			tracker.FailStep(errInstall)
+			if got := tracker.Status().LastError; !errors.Is(got, errInstall) {
+				t.Errorf("Status().LastError after FailStep = %v, want %v", got, errInstall)
+			}
+		}
+		tracker.NextStep()
+	}
+
+	final := tracker.Status()
+	checkStatusConsistent(t, final)
+	if !final.Completed || final.CurrentStep != len(steps) || !errors.Is(final.LastError, errInstall) {
+		t.Errorf("final Status() = %+v, want completed with the install error kept", final)
+	}
+}
+
+func TestStatusIsConsistentDuringConcurrentAdvances(t *testing.T) {
+	steps := make([]Step, 50)
+	for i := range steps {
+		steps[i] = Step{Name: fmt.Sprintf("step %d", i), Duration: time.Second}
+	}
+	tracker := NewTracker(steps)
+	tracker.Start()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for tracker.NextStep() {
+		}
+	}()
+
+	for {
+		checkStatusConsistent(t, tracker.Status())
+		select {
+		case <-done:
+			checkStatusConsistent(t, tracker.Status())
+			return
+		default:
+		}
+	}
+}
+
+func TestOnStepCompleteFiresOncePerStep(t *testing.T) {
+	errLint := errors.New("lint: 3 problems")
+	errBuild := errors.New("build: exit status 2")
+	tracker, now := newClockedTracker([]Step{
+		{Name: "Scaffolding"},
+		{Name: "Linting"},
+		{Name: "Building"},
+		{Name: "Finalizing"},
+	})
+
+	results := make(chan StepResult, 8)
+	tracker.OnStepComplete(func(result StepResult) { results <- result })
+
+	*now = now.Add(time.Second)
+	tracker.NextStep()
+	tracker.FailStep(errLint)
+	tracker.NextStep()
+	tracker.Fail(errBuild)
+
+	// Once the run has failed nothing else may be reported
+	tracker.Fail(errors.New("second failure"))
+	if tracker.NextStep() {
+		t.Error("NextStep() after Fail = true, want the run to stay ended")
+	}
+
+	want := []struct {
+		name   string
+		status string
+		err    error
+	}{
+		{"Scaffolding", StepSucceeded, nil},
+		{"Linting", StepFailed, errLint},
+		{"Building", StepFailed, errBuild},
+	}
+	for i, w := range want {
+		select {
+		case result := <-results:
+			if result.Index != i || result.Name != w.name || result.Status != w.status || !errors.Is(result.Error, w.err) {
+				t.Errorf("result %d = %+v, want %s %s with error %v", i, result, w.name, w.status, w.err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("OnStepComplete reported %d steps, want %d", i, len(want))
+		}
+	}
+
+	select {
+	case result := <-results:
+		t.Errorf("unexpected extra step result %+v", result)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if !tracker.IsFailed() {
+		t.Error("IsFailed() = false after Fail")
+	}
+	if got := tracker.GetError(); !errors.Is(got, errBuild) {
+		t.Errorf("GetError() = %v, want the first failure", got)
+	}
+}
+
+func TestFailDefaultsToPendingStepError(t *testing.T) {
+	errScaffold := errors.New("scaffold: permission denied")
+	tracker, _ := newClockedTracker([]Step{{Name: "Scaffolding"}})
+
+	results := make(chan StepResult, 1)
+	tracker.OnStepComplete(func(result StepResult) { results <- result })
+
+	tracker.FailStep(errScaffold)
+	tracker.Fail(nil)
+
+	select {
+	case result := <-results:
+		if result.Status != StepFailed || !errors.Is(result.Error, errScaffold) {
+			t.Errorf("step result = %+v, want failed with the pending step error", result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnStepComplete never reported the failed step")
+	}
+}
@@ -1,5 +1,7 @@
 package components
 
+import "github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+
 // ComponentInstallationPhase defines when components get installed
 type ComponentInstallationPhase int
 
@@ -18,8 +20,12 @@ type ComponentInstallationStep struct {
 	ProgressEnd     float64 // 0.0 to 1.0 - when this step completes within the phase
 	ComponentNames  []string
 	SuccessRate     float64 // 0.0 to 1.0 - for future failure simulation
+	AllowOverlap    bool    // Intentionally installs alongside other windows in its phase
 }
 
+// DefaultSuccessRateFactor leaves the planned component success rates unchanged
+const DefaultSuccessRateFactor = 1.0
+
 // ComponentManager handles the installation simulation of all components
 type ComponentManager struct {
 	installationPlan []ComponentInstallationStep
@@ -27,8 +33,9 @@ type ComponentManager struct {
 	phaseProgress    float64
 }
 
-// NewComponentManager creates a new component manager with the default installation plan
-func NewComponentManager() *ComponentManager {
+// NewComponentManager creates a new component manager with the default installation plan.
+// Every step's success rate is multiplied by successRateFactor and clamped to 0-1.
+func NewComponentManager(successRateFactor float64) *ComponentManager {
 	plan := []ComponentInstallationStep{
 		// DEPENDENCIES PHASE: Core Technologies (10%-60%) + EngX Start (70%-80%)
 		{
@@ -126,6 +133,22 @@ func NewComponentManager() *ComponentManager {
 			ComponentNames: []string{"Vitest"},
 			SuccessRate:    0.95,
 		},
+		{
+			Phase:          PhaseTestingFrameworks,
+			ProgressStart:  0.35,
+			ProgressEnd:    0.5,
+			ComponentNames: []string{"Vitest Coverage (v8)"},
+			SuccessRate:    0.95,
+			AllowOverlap:   true, // Installs alongside Vitest
+		},
+		{
+			Phase:          PhaseTestingFrameworks,
+			ProgressStart:  0.4,
+			ProgressEnd:    0.5,
+			ComponentNames: []string{"Playwright (E2E)"},
+			SuccessRate:    0.93,
+			AllowOverlap:   true, // Installs alongside Vitest
+		},
 		{
 			Phase:          PhaseTestingFrameworks,
 			ProgressStart:  0.5,
@@ -147,6 +170,26 @@ func NewComponentManager() *ComponentManager {
 			ComponentNames: []string{"StoryBook (UI Components & Documentation)"},
 			SuccessRate:    0.92,
 		},
+
+		// DOCUMENTATION PHASE: Generated docs (0%-100%)
+		{
+			Phase:          PhaseDocumentation,
+			ProgressStart:  0.0,
+			ProgressEnd:    0.5,
+			ComponentNames: []string{"TypeDoc (API Reference)"},
+			SuccessRate:    0.97,
+		},
+		{
+			Phase:          PhaseDocumentation,
+			ProgressStart:  0.5,
+			ProgressEnd:    1.0,
+			ComponentNames: []string{"Component Docs (README & MDX)"},
+			SuccessRate:    0.98,
+		},
+	}
+
+	for i := range plan {
+		plan[i].SuccessRate = scaleSuccessRate(plan[i].SuccessRate, successRateFactor)
 	}
 
 	return &ComponentManager{
@@ -156,6 +199,29 @@ func NewComponentManager() *ComponentManager {
 	}
 }
 
+// scaleSuccessRate multiplies a success rate by a factor, clamped to 0-1
+func scaleSuccessRate(rate, factor float64) float64 {
+	scaled := rate * factor
+	if scaled < 0 {
+		return 0
+	}
+	if scaled > 1 {
+		return 1
+	}
+	return scaled
+}
+
+// GetEffectiveSuccessRates returns the scaled success rate of each component
+func (cm *ComponentManager) GetEffectiveSuccessRates() map[string]float64 {
+	rates := make(map[string]float64)
+	for _, step := range cm.installationPlan {
+		for _, componentName := range step.ComponentNames {
+			rates[componentName] = step.SuccessRate
+		}
+	}
+	return rates
+}
+
 // GetInstallationUpdates returns the components that should change status at the given phase and progress
 func (cm *ComponentManager) GetInstallationUpdates(phase ComponentInstallationPhase, progress float64) []ComponentUpdate {
 	var updates []ComponentUpdate
@@ -172,14 +238,14 @@ func (cm *ComponentManager) GetInstallationUpdates(phase ComponentInstallationPh
 				updates = append(updates, ComponentUpdate{
 					ComponentName: componentName,
 					NewStatus:     "installing",
-					NewIcon:       "[✓ ]",
+					NewIcon:       styles.CurrentGlyphs().Running,
 				})
 			} else if progress >= step.ProgressEnd {
 				// Component should be installed
 				updates = append(updates, ComponentUpdate{
 					ComponentName: componentName,
 					NewStatus:     "installed",
-					NewIcon:       "[✓]",
+					NewIcon:       styles.CurrentGlyphs().Complete,
 				})
 			}
 		}
@@ -201,7 +267,7 @@ func (cm *ComponentManager) GetAllComponentsUpToPhase(phase ComponentInstallatio
 					updates = append(updates, ComponentUpdate{
 						ComponentName: componentName,
 						NewStatus:     "installed",
-						NewIcon:       "[✓]",
+						NewIcon:       styles.CurrentGlyphs().Complete,
 					})
 					processedComponents[componentName] = true
 				}
@@ -234,7 +300,7 @@ func (cm *ComponentManager) GetAllComponentsForPhase(phase ComponentInstallation
 					updates = append(updates, ComponentUpdate{
 						ComponentName: componentName,
 						NewStatus:     "installed",
-						NewIcon:       "[✓]",
+						NewIcon:       styles.CurrentGlyphs().Complete,
 					})
 					processedComponents[componentName] = true
 				}
@@ -254,18 +320,36 @@ type ComponentUpdate struct {
 
 // MapStepNameToPhase converts step names to installation phases
 func MapStepNameToPhase(stepName string) ComponentInstallationPhase {
+	phase, _ := LookupStepPhase(stepName)
+	return phase
+}
+
+// LookupStepPhase converts a step name to its installation phase, reporting
+// whether the step has a phase at all (unknown steps default to dependencies)
+func LookupStepPhase(stepName string) (ComponentInstallationPhase, bool) {
 	switch stepName {
 	case "Installing Dependencies", "Installing dependencies":
-		return PhaseDependencies
+		return PhaseDependencies, true
 	case "Generating Project Structure", "Generating project structure":
-		return PhaseProjectStructure
+		return PhaseProjectStructure, true
 	case "Installing Testing Frameworks", "Installing testing frameworks":
-		return PhaseTestingFrameworks
+		return PhaseTestingFrameworks, true
 	case "Generating Documentation", "Generating documentation":
-		return PhaseDocumentation
+		return PhaseDocumentation, true
 	case "Finalizing Setup", "Finalizing setup":
-		return PhaseFinalizing
+		return PhaseFinalizing, true
 	default:
-		return PhaseDependencies
+		return PhaseDependencies, false
+	}
+}
+
+// GetComponentsForPhase returns the names of the components installed during a phase, in plan order
+func (cm *ComponentManager) GetComponentsForPhase(phase ComponentInstallationPhase) []string {
+	var names []string
+	for _, step := range cm.installationPlan {
+		if step.Phase == phase {
+			names = append(names, step.ComponentNames...)
+		}
 	}
+	return names
 }
\ No newline at end of file
@@ -0,0 +1,86 @@
+package components
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestComponentSuccessRateFactorScalesRates(t *testing.T) {
+	base := NewComponentManager(DefaultSuccessRateFactor).GetEffectiveSuccessRates()
+	if len(base) == 0 {
+		t.Fatal("GetEffectiveSuccessRates() is empty")
+	}
+
+	tests := []struct {
+		name   string
+		factor float64
+		want   func(rate float64) float64
+	}{
+		{"halved", 0.5, func(rate float64) float64 { return rate * 0.5 }},
+		{"zeroed", 0, func(float64) float64 { return 0 }},
+		{"negative clamps to zero", -2, func(float64) float64 { return 0 }},
+		{"large clamps to one", 10, func(float64) float64 { return 1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scaled := NewComponentManager(tt.factor).GetEffectiveSuccessRates()
+			for name, rate := range base {
+				if got, want := scaled[name], tt.want(rate); math.Abs(got-want) > 1e-9 {
+					t.Errorf("%s success rate = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestComponentUpdateOrderingIsDeterministic(t *testing.T) {
+	phases := []ComponentInstallationPhase{PhaseDependencies, PhaseTestingFrameworks, PhaseDocumentation}
+
+	for _, phase := range phases {
+		for _, progress := range []float64{0.25, 0.5, 1.0} {
+			first := NewComponentManager(DefaultSuccessRateFactor).GetAllComponentsUpToPhase(phase, progress)
+			second := NewComponentManager(DefaultSuccessRateFactor).GetAllComponentsUpToPhase(phase, progress)
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("phase %d at %.2f: update order differs between runs:\n%v\n%v", phase, progress, first, second)
+			}
+		}
+
+		first := NewComponentManager(DefaultSuccessRateFactor).GetAllComponentsForPhase(phase)
+		second := NewComponentManager(DefaultSuccessRateFactor).GetAllComponentsForPhase(phase)
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("phase %d: final update order differs between runs:\n%v\n%v", phase, first, second)
+		}
+	}
+}
+
+func TestDocumentationStepInstallsComponents(t *testing.T) {
+	phase := MapStepNameToPhase("Generating Documentation")
+	if phase != PhaseDocumentation {
+		t.Fatalf("MapStepNameToPhase() = %d, want PhaseDocumentation", phase)
+	}
+
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	if got := cm.GetComponentsForPhase(PhaseDocumentation); len(got) == 0 {
+		t.Fatal("no components planned for the documentation phase")
+	}
+
+	installing := cm.GetInstallationUpdates(PhaseDocumentation, 0.6)
+	if len(installing) == 0 {
+		t.Fatal("documentation step part-way through produced no component updates")
+	}
+
+	done := cm.GetInstallationUpdates(PhaseDocumentation, 1.0)
+	for _, name := range cm.GetComponentsForPhase(PhaseDocumentation) {
+		found := false
+		for _, update := range done {
+			if update.ComponentName == name && update.NewStatus == "installed" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%q is not installed when the documentation step completes: %+v", name, done)
+		}
+	}
+}
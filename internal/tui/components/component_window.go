@@ -0,0 +1,145 @@
+package components
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ComponentWindow is the span of a phase's progress (0.0 to 1.0) during which
+// a component installs
+type ComponentWindow struct {
+	Start   float64 `yaml:"start"`
+	End     float64 `yaml:"end"`
+	Overlap bool    `yaml:"overlap,omitempty"` // Allow installing alongside other windows
+}
+
+// componentWindowsFile is the on-disk layout for LoadComponentWindows
+type componentWindowsFile struct {
+	Testing map[string]ComponentWindow `yaml:"testing"`
+}
+
+// LoadComponentWindows reads testing-phase component windows from a YAML file:
+//
+//	testing:
+//	  "StoryBook (UI Components & Documentation)": {start: 0.0, end: 0.25}
+//	  "GitHub Pages": {start: 0.3, end: 0.45, overlap: true}
+//
+// Every name and window is checked against the default plan before returning.
+func LoadComponentWindows(path string) (map[string]ComponentWindow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read component windows: %w", err)
+	}
+
+	var file componentWindowsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse component windows at %s: %w", path, err)
+	}
+
+	if err := NewComponentManager(DefaultSuccessRateFactor).SetPhaseWindows(PhaseTestingFrameworks, file.Testing); err != nil {
+		return nil, fmt.Errorf("invalid component windows in %s: %w", path, err)
+	}
+
+	return file.Testing, nil
+}
+
+// SetPhaseWindows moves the named components of phase to new progress windows.
+// The plan is left unchanged if any name is unknown, any window is invalid,
+// or the result has windows that overlap without AllowOverlap.
+func (cm *ComponentManager) SetPhaseWindows(phase ComponentInstallationPhase, windows map[string]ComponentWindow) error {
+	for name, window := range windows {
+		if window.Start < 0 || window.End > 1 || window.Start >= window.End {
+			return fmt.Errorf("window for %q must satisfy 0 <= start < end <= 1, got %g-%g", name, window.Start, window.End)
+		}
+		if !containsComponent(cm.GetComponentsForPhase(phase), name) {
+			return fmt.Errorf("component %q is not installed during this phase", name)
+		}
+	}
+
+	var plan []ComponentInstallationStep
+	for _, step := range cm.installationPlan {
+		if step.Phase != phase {
+			plan = append(plan, step)
+			continue
+		}
+
+		// Split re-windowed components out of shared steps, keeping plan order
+		var remaining []string
+		var moved []ComponentInstallationStep
+		for _, name := range step.ComponentNames {
+			window, ok := windows[name]
+			if !ok {
+				remaining = append(remaining, name)
+				continue
+			}
+			movedStep := step
+			movedStep.ProgressStart = window.Start
+			movedStep.ProgressEnd = window.End
+			movedStep.ComponentNames = []string{name}
+			movedStep.AllowOverlap = window.Overlap
+			moved = append(moved, movedStep)
+		}
+		if len(remaining) > 0 {
+			step.ComponentNames = remaining
+			plan = append(plan, step)
+		}
+		plan = append(plan, moved...)
+	}
+
+	if err := validatePhaseWindows(plan, phase); err != nil {
+		return err
+	}
+
+	cm.installationPlan = plan
+	return nil
+}
+
+// Validate checks that no two windows in a phase overlap unless one of them
+// sets AllowOverlap; overlapping windows make components flip state erratically
+func (cm *ComponentManager) Validate() error {
+	for _, phase := range allInstallationPhases {
+		if err := validatePhaseWindows(cm.installationPlan, phase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePhaseWindows reports the first unintended overlap within phase;
+// windows that only touch (one ends where the next starts) don't overlap
+func validatePhaseWindows(plan []ComponentInstallationStep, phase ComponentInstallationPhase) error {
+	var steps []ComponentInstallationStep
+	for _, step := range plan {
+		if step.Phase == phase {
+			steps = append(steps, step)
+		}
+	}
+
+	for i := range steps {
+		for j := i + 1; j < len(steps); j++ {
+			a, b := steps[i], steps[j]
+			if a.AllowOverlap || b.AllowOverlap {
+				continue
+			}
+			if a.ProgressStart < b.ProgressEnd && b.ProgressStart < a.ProgressEnd {
+				return fmt.Errorf("%s windows overlap: %s (%g-%g) and %s (%g-%g); move one or set overlap: true",
+					phase, strings.Join(a.ComponentNames, ", "), a.ProgressStart, a.ProgressEnd,
+					strings.Join(b.ComponentNames, ", "), b.ProgressStart, b.ProgressEnd)
+			}
+		}
+	}
+	return nil
+}
+
+// containsComponent reports whether names includes name
+func containsComponent(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package components
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const storybook = "StoryBook (UI Components & Documentation)"
+
+// writeWindowsFile writes a component windows YAML file to a temp dir
+func writeWindowsFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "windows.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("writing windows file: %v", err)
+	}
+	return path
+}
+
+// statusAt returns name's status after the testing phase reaches progress
+func statusAt(cm *ComponentManager, name string, progress float64) string {
+	for _, update := range cm.GetInstallationUpdates(PhaseTestingFrameworks, progress) {
+		if update.ComponentName == name {
+			return update.NewStatus
+		}
+	}
+	return "queued"
+}
+
+func TestLoadedWindowsMoveStorybookEarlier(t *testing.T) {
+	path := writeWindowsFile(t, `testing:
+  "StoryBook (UI Components & Documentation)": {start: 0.0, end: 0.2}
+`)
+
+	windows, err := LoadComponentWindows(path)
+	if err != nil {
+		t.Fatalf("LoadComponentWindows() error = %v", err)
+	}
+
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	if got := statusAt(cm, storybook, 0.1); got != "queued" {
+		t.Fatalf("default plan: Storybook at 10%% = %q, want queued", got)
+	}
+
+	if err := cm.SetPhaseWindows(PhaseTestingFrameworks, windows); err != nil {
+		t.Fatalf("SetPhaseWindows() error = %v", err)
+	}
+	for _, tt := range []struct {
+		progress float64
+		want     string
+	}{
+		{0.1, "installing"},
+		{0.2, "installed"},
+		{0.95, "installed"},
+	} {
+		if got := statusAt(cm, storybook, tt.progress); got != tt.want {
+			t.Errorf("Storybook at %.0f%% = %q, want %q", tt.progress*100, got, tt.want)
+		}
+	}
+	if err := cm.Validate(); err != nil {
+		t.Errorf("Validate() after moving Storybook = %v", err)
+	}
+}
+
+func TestLoadComponentWindowsRejectsBadPlans(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "unknown component", content: "testing:\n  \"Jest\": {start: 0.0, end: 0.2}\n"},
+		{name: "inverted window", content: "testing:\n  \"GitHub Pages\": {start: 0.5, end: 0.4}\n"},
+		{name: "overlapping window", content: "testing:\n  \"GitHub Pages\": {start: 0.6, end: 0.7}\n"},
+		{name: "malformed yaml", content: "testing: [unclosed\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadComponentWindows(writeWindowsFile(t, tt.content)); err == nil {
+				t.Error("LoadComponentWindows() error = nil, want the plan rejected")
+			}
+		})
+	}
+}
+
+func TestOverlappingWindowsAreRejected(t *testing.T) {
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	if err := cm.Validate(); err != nil {
+		t.Fatalf("default plan Validate() = %v, want no overlaps", err)
+	}
+
+	// GitHub Pages (0.6-0.7) would overlap EngX TypeScript Linters (0.5-0.75)
+	err := cm.SetPhaseWindows(PhaseTestingFrameworks, map[string]ComponentWindow{
+		"GitHub Pages": {Start: 0.6, End: 0.7},
+	})
+	if err == nil {
+		t.Fatal("SetPhaseWindows() error = nil, want the overlap rejected")
+	}
+	for _, want := range []string{"overlap", "GitHub Pages", "EngX TypeScript Linters"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("SetPhaseWindows() error = %q, want it to mention %q", err, want)
+		}
+	}
+	if got := statusAt(cm, "GitHub Pages", 0.65); got != "queued" {
+		t.Errorf("GitHub Pages at 65%% = %q after a rejected change, want the plan unchanged", got)
+	}
+
+	tests := []struct {
+		name   string
+		window ComponentWindow
+	}{
+		{name: "intentional overlap", window: ComponentWindow{Start: 0.6, End: 0.7, Overlap: true}},
+		{name: "touching windows", window: ComponentWindow{Start: 0.1, End: 0.25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := NewComponentManager(DefaultSuccessRateFactor)
+			if err := cm.SetPhaseWindows(PhaseTestingFrameworks, map[string]ComponentWindow{"GitHub Pages": tt.window}); err != nil {
+				t.Errorf("SetPhaseWindows() error = %v, want it accepted", err)
+			}
+			if err := cm.Validate(); err != nil {
+				t.Errorf("Validate() = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateReportsOverlappingPlan(t *testing.T) {
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	cm.installationPlan = append(cm.installationPlan, ComponentInstallationStep{
+		Phase:          PhaseTestingFrameworks,
+		ProgressStart:  0.8,
+		ProgressEnd:    0.95,
+		ComponentNames: []string{"Extra"},
+	})
+
+	if err := cm.Validate(); err == nil || !strings.Contains(err.Error(), "Extra") {
+		t.Errorf("Validate() = %v, want an overlap error naming Extra", err)
+	}
+}
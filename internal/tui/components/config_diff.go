@@ -0,0 +1,38 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+// FormatConfigDiff renders changes one per line, coloring additions, removals
+// and modifications with the theme's done, failed and warning colors (nil =
+// styles.DefaultTheme). Nothing is colored when colorEnabled is false.
+func FormatConfigDiff(changes []config.ConfigChange, theme *styles.Theme, colorEnabled bool) string {
+	if len(changes) == 0 {
+		return "No configuration changes\n"
+	}
+	if theme == nil {
+		theme = styles.DefaultTheme()
+	}
+	if !colorEnabled {
+		theme = styles.MonochromeTheme()
+	}
+
+	var output strings.Builder
+	for _, change := range changes {
+		switch change.Kind {
+		case config.ChangeAdded:
+			fmt.Fprintf(&output, "%s+ %s%s\n", theme.Done, change.Label, theme.Reset)
+		case config.ChangeRemoved:
+			fmt.Fprintf(&output, "%s- %s%s\n", theme.Failed, change.Label, theme.Reset)
+		default:
+			fmt.Fprintf(&output, "%s~ %s: %s → %s%s\n", theme.Warning, change.Label, change.From, change.To, theme.Reset)
+		}
+	}
+
+	return output.String()
+}
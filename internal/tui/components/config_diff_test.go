@@ -0,0 +1,42 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+func TestFormatConfigDiffMarkers(t *testing.T) {
+	changes := []config.ConfigChange{
+		{Kind: config.ChangeAdded, Label: "Docker"},
+		{Kind: config.ChangeRemoved, Label: "Analytics"},
+		{Kind: config.ChangeModified, Label: "template", From: "javascript", To: "typescript"},
+	}
+
+	for name, theme := range map[string]*styles.Theme{
+		"default":       styles.DefaultTheme(),
+		"high-contrast": styles.HighContrastTheme(),
+	} {
+		colored := FormatConfigDiff(changes, theme, true)
+		for _, want := range []string{
+			theme.Done + "+ Docker" + theme.Reset,
+			theme.Failed + "- Analytics" + theme.Reset,
+			theme.Warning + "~ template: javascript → typescript" + theme.Reset,
+		} {
+			if !strings.Contains(colored, want) {
+				t.Errorf("%s theme diff is missing %q:\n%q", name, want, colored)
+			}
+		}
+	}
+
+	plain := FormatConfigDiff(changes, styles.DefaultTheme(), false)
+	if want := "+ Docker\n- Analytics\n~ template: javascript → typescript\n"; plain != want {
+		t.Errorf("plain diff = %q, want %q", plain, want)
+	}
+
+	if got := FormatConfigDiff(nil, nil, true); got != "No configuration changes\n" {
+		t.Errorf("empty diff = %q", got)
+	}
+}
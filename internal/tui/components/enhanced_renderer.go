@@ -2,35 +2,58 @@ package components
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"time"
-)
 
-// ANSI color codes for styling
-const (
-	// Basic colors
-	colorReset         = "\033[0m"
-	colorWhite         = "\033[97m"  // Bright white
-	colorBlue          = "\033[94m"  // Blue for running/installing
-	colorYellow        = "\033[93m"  // Yellow for stalling/paused/JavaScript
-	colorRed           = "\033[91m"  // Red for failed
-	colorGreen         = "\033[92m"  // Green for done/installed
-	colorLightGrey     = "\033[90m"  // Darker grey for lines
-	colorGrey          = "\033[37m"  // Light grey for skipped items
-
-	// Header-specific colors
-	colorBrightMagenta = "\033[95m"  // Bright magenta for app name/paths
-	colorBrightOrange  = "\033[38;5;208m"  // Bright orange for PRODUCTION READY
-
-	// Text styles
-	styleBold   = "\033[1m"
-	styleItalic = "\033[3m"
-	styleReset  = "\033[22m"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	"github.com/mattn/go-runewidth"
 )
 
-// Helper function to create colored separator lines
+// Helper function to create colored separator lines (cached per width)
 func (r *EnhancedRenderer) renderSeparatorLine() string {
-	return fmt.Sprintf("%s%s%s", colorLightGrey, strings.Repeat("-", r.totalWidth), colorReset)
+	return r.dashRun(r.totalWidth)
+}
+
+// dashRun returns a colored run of n dashes, cached until the width changes
+func (r *EnhancedRenderer) dashRun(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	if r.dashCache == nil {
+		r.dashCache = make(map[int]string)
+	}
+	if cached, exists := r.dashCache[n]; exists {
+		return cached
+	}
+
+	run := fmt.Sprintf("%s%s%s", r.theme.Dashes, strings.Repeat(string(r.separatorRune()), n), r.theme.Reset)
+	r.dashCache[n] = run
+	return run
+}
+
+// separatorRune returns the configured separator or the default dash
+func (r *EnhancedRenderer) separatorRune() rune {
+	if r.separator == 0 {
+		return styles.DefaultSeparator
+	}
+	return r.separator
+}
+
+// SetSeparator sets the rune used for separator lines and dash runs
+func (r *EnhancedRenderer) SetSeparator(sep rune) {
+	r.separator = sep
+	r.dashCache = make(map[int]string)
+}
+
+// Resize updates the layout width and invalidates width-dependent caches
+func (r *EnhancedRenderer) Resize(width int) {
+	if width == r.totalWidth && r.dashCache != nil {
+		return
+	}
+	r.totalWidth = width
+	r.dashCache = make(map[int]string)
 }
 
 // EnhancedRenderer renders progress in the comprehensive template format
@@ -55,13 +78,56 @@ type EnhancedRenderer struct {
 
 	// Component management
 	componentManager *ComponentManager
+
+	// Render caches (invalidated on resize)
+	dashCache map[int]string
+
+	// Color output (disabled for dumb terminals, NO_COLOR, or --color=never)
+	colorEnabled bool
+
+	// Palette for every colored element
+	theme *styles.Theme
+
+	// Sub-step lines under the running step (verbose detail, independent of verbosity)
+	showSubSteps bool
+
+	// Recorded duration after each completed step's percentage (verbose detail)
+	showStepDurations bool
+
+	// Section visibility (components, timings); nil shows everything
+	verbosity *config.VerbosityConfig
+
+	// Separator rune for dash runs (0 = styles.DefaultSeparator)
+	separator rune
+
+	// Optional single source of truth for footer timing
+	timing TimingSource
+
+	// Injectable clock; defaults to time.Now
+	now func() time.Time
+
+	// Scrolling window over the step list (0 = show every step)
+	maxVisibleSteps int
+
+	// Step shown as stalling while a chaos delay plays out (-1 = none)
+	stallingStep int
+
+	// Custom testing-phase component windows, kept across manager rebuilds
+	componentWindows map[string]ComponentWindow
+}
+
+// TimingSource supplies elapsed and remaining time, e.g. a progress.Tracker
+type TimingSource interface {
+	TotalElapsed() time.Duration
+	EstimatedTimeRemaining() time.Duration
+	TotalEstimatedDuration() time.Duration
 }
 
 // Component represents any technology component with status
 type Component struct {
 	Name   string
 	Status string // "installed", "installing", "queued"
-	Icon   string // "[✓]", "[✓ ]", "[ ]"
+	Icon   string // Overrides the styles.CurrentGlyphs() status icon when set
 }
 
 // QualityComponent represents a quality/testing tool
@@ -96,8 +162,8 @@ func NewEnhancedRenderer(appName, targetDir, template string, stepNames []string
 	// Calculate optimal layout dimensions
 	maxStepNameLength := 0
 	for _, name := range stepNames {
-		if len(name) > maxStepNameLength {
-			maxStepNameLength = len(name)
+		if width := styles.VisibleWidth(name); width > maxStepNameLength {
+			maxStepNameLength = width
 		}
 	}
 
@@ -125,6 +191,8 @@ func NewEnhancedRenderer(appName, targetDir, template string, stepNames []string
 		{"EngX TypeScript Linters", "queued"},
 		{"GitHub Pages", "queued"},
 		{"StoryBook (UI Components & Documentation)", "queued"},
+		{"TypeDoc (API Reference)", "queued"},
+		{"Component Docs (README & MDX)", "queued"},
 	}
 
 	return &EnhancedRenderer{
@@ -141,8 +209,199 @@ func NewEnhancedRenderer(appName, targetDir, template string, stepNames []string
 		coreTechnologies:  coreTechnologies,
 		engxIntegrations:  engxIntegrations,
 		qualityComponents: qualityComponents,
-		componentManager:  NewComponentManager(),
+		componentManager:  NewComponentManager(DefaultSuccessRateFactor),
+		colorEnabled:      true,
+		theme:             styles.DefaultTheme(),
+		now:               time.Now,
+		stallingStep:      -1,
+	}
+}
+
+// NewEnhancedRendererWithColor creates an enhanced renderer with an explicit
+// color decision, e.g. from styles.ColorEnabled
+func NewEnhancedRendererWithColor(appName, targetDir, template string, stepNames []string, isDevOnly bool, enableColor bool) *EnhancedRenderer {
+	renderer := NewEnhancedRenderer(appName, targetDir, template, stepNames, isDevOnly)
+	renderer.SetColorEnabled(enableColor)
+	return renderer
+}
+
+// NewEnhancedRendererFromConfig creates an enhanced renderer whose component
+// sections list only what the user's configuration selected, so deselected
+// components are omitted rather than left queued
+func NewEnhancedRendererFromConfig(appName, targetDir string, cfg *config.UserConfiguration, stepNames []string) *EnhancedRenderer {
+	if cfg == nil {
+		return NewEnhancedRenderer(appName, targetDir, config.TypeScript.String(), stepNames, false)
 	}
+
+	template := cfg.Template.Type.String()
+	renderer := NewEnhancedRenderer(appName, targetDir, template, stepNames, cfg.ProductionSetup.IsDevOnly())
+	renderer.coreTechnologies = coreTechnologiesFor(cfg.Template.Type)
+	renderer.SetTestingConfig(cfg.Testing)
+	renderer.SetProductionConfig(cfg.ProductionSetup)
+	return renderer
+}
+
+// coreTechnologiesFor builds the core technology components for a template;
+// TypeScript is only installed by the TypeScript template
+func coreTechnologiesFor(template config.TemplateType) []Component {
+	var core []Component
+	if template == config.TypeScript {
+		core = append(core, Component{"TypeScript", "queued", ""})
+	}
+
+	return append(core,
+		Component{"React", "queued", ""},
+		Component{"React Router 7", "queued", ""},
+		Component{"Tailwind CSS", "queued", ""},
+		Component{"Radix UI", "queued", ""},
+		Component{"ShadCN-based UI Design System (SUDS)", "queued", ""},
+	)
+}
+
+// SetTestingConfig derives the quality & testing section from the user's testing selections
+func (r *EnhancedRenderer) SetTestingConfig(testing config.TestingConfig) {
+	r.qualityComponents = qualityComponentsFor(testing)
+}
+
+// SetProductionConfig derives the EngX integrations from the user's production
+// selections, so data-access components only install when chosen
+func (r *EnhancedRenderer) SetProductionConfig(production config.ProductionConfig) {
+	r.engxIntegrations = engxIntegrationsFor(production)
+}
+
+// engxIntegrationsFor builds the EngX integration components for a production configuration
+func engxIntegrationsFor(production config.ProductionConfig) []Component {
+	var integrations []Component
+	if production.TrustBridge {
+		integrations = append(integrations, Component{"TrustBridge SSO", "queued", ""})
+	}
+	if production.GRPC {
+		integrations = append(integrations, Component{"gRPC Web", "queued", ""})
+	}
+	if production.GridHDFS {
+		integrations = append(integrations, Component{"GRID/HDFS Access", "queued", ""})
+	}
+
+	return append(integrations,
+		Component{"CREWS API", "queued", ""},
+		Component{"LI CATALOG API", "queued", ""},
+		Component{"GitHub Actions", "queued", ""},
+	)
+}
+
+// qualityComponentsFor builds the quality components for a testing configuration
+func qualityComponentsFor(testing config.TestingConfig) []QualityComponent {
+	var quality []QualityComponent
+	if testing.UnitTesting {
+		quality = append(quality, QualityComponent{"Vitest", "queued"})
+	}
+	if testing.Coverage {
+		quality = append(quality, QualityComponent{"Vitest Coverage (v8)", "queued"})
+	}
+	if testing.E2ETesting {
+		quality = append(quality, QualityComponent{"Playwright (E2E)", "queued"})
+	}
+
+	return append(quality,
+		QualityComponent{"EngX TypeScript Linters", "queued"},
+		QualityComponent{"GitHub Pages", "queued"},
+		QualityComponent{"StoryBook (UI Components & Documentation)", "queued"},
+		QualityComponent{"TypeDoc (API Reference)", "queued"},
+		QualityComponent{"Component Docs (README & MDX)", "queued"},
+	)
+}
+
+// SetComponentSuccessRateFactor scales every component's success rate by factor
+func (r *EnhancedRenderer) SetComponentSuccessRateFactor(factor float64) {
+	r.componentManager = NewComponentManager(factor)
+	if r.componentWindows != nil {
+		_ = r.componentManager.SetPhaseWindows(PhaseTestingFrameworks, r.componentWindows)
+	}
+}
+
+// SetComponentWindows overrides when testing-phase components install
+func (r *EnhancedRenderer) SetComponentWindows(windows map[string]ComponentWindow) error {
+	if err := r.componentManager.SetPhaseWindows(PhaseTestingFrameworks, windows); err != nil {
+		return err
+	}
+	r.componentWindows = windows
+	return nil
+}
+
+// SetTracker makes the footer read elapsed and remaining time from the tracker
+// instead of the renderer's own clock, so the two can't drift apart
+func (r *EnhancedRenderer) SetTracker(timing TimingSource) {
+	r.timing = timing
+}
+
+// SetClock replaces the renderer's time source and restarts its elapsed timer on it
+func (r *EnhancedRenderer) SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	r.now = now
+	r.startTime = now()
+}
+
+// SetMaxVisibleSteps limits the step list to a window of n steps centered on
+// the current one (0 shows every step)
+func (r *EnhancedRenderer) SetMaxVisibleSteps(n int) {
+	if n < 0 {
+		n = 0
+	}
+	r.maxVisibleSteps = n
+}
+
+// visibleStepRange returns the [start, end) window of steps to render
+func (r *EnhancedRenderer) visibleStepRange() (int, int) {
+	total := len(r.steps)
+	if r.maxVisibleSteps <= 0 || total <= r.maxVisibleSteps {
+		return 0, total
+	}
+
+	start := r.currentStep - r.maxVisibleSteps/2
+	if start < 0 {
+		start = 0
+	}
+	if start > total-r.maxVisibleSteps {
+		start = total - r.maxVisibleSteps
+	}
+	return start, start + r.maxVisibleSteps
+}
+
+// SetColorEnabled controls whether the rendered output contains ANSI colors
+func (r *EnhancedRenderer) SetColorEnabled(enabled bool) {
+	r.colorEnabled = enabled
+}
+
+// SetTheme sets the palette used for colored output (nil = styles.DefaultTheme)
+func (r *EnhancedRenderer) SetTheme(theme *styles.Theme) {
+	if theme == nil {
+		theme = styles.DefaultTheme()
+	}
+	r.theme = theme
+	r.dashCache = make(map[int]string)
+}
+
+// SetVerbosity hides the sections the verbosity level turns off, such as
+// Application Components in concise mode (nil shows everything)
+func (r *EnhancedRenderer) SetVerbosity(verbosity *config.VerbosityConfig) {
+	r.verbosity = verbosity
+}
+
+// shouldShow reports whether a verbosity-controlled section is visible
+func (r *EnhancedRenderer) shouldShow(contentType string) bool {
+	return r.verbosity == nil || r.verbosity.ShouldShow(contentType)
+}
+
+// SetShowSubSteps controls whether sub-step lines are shown under the running step
+func (r *EnhancedRenderer) SetShowSubSteps(show bool) {
+	r.showSubSteps = show
+}
+
+// SetShowStepDurations controls whether completed steps show how long they took
+func (r *EnhancedRenderer) SetShowStepDurations(show bool) {
+	r.showStepDurations = show
 }
 
 // UpdateStep updates the current step's progress and status
@@ -159,13 +418,25 @@ func (r *EnhancedRenderer) UpdateStep(stepIndex int, progress float64, message s
 
 		if progress >= 1.0 {
 			r.steps[stepIndex].Status = StepComplete
-			r.steps[stepIndex].Duration = time.Since(r.startTime)
+			r.steps[stepIndex].Duration = r.now().Sub(r.startTime)
 		} else if progress > 0 {
 			r.steps[stepIndex].Status = StepRunning
 		}
 	}
 }
 
+// SetStallingStep shows a step in the yellow stalling state until cleared with -1
+func (r *EnhancedRenderer) SetStallingStep(stepIndex int) {
+	r.stallingStep = stepIndex
+}
+
+// FailStep marks a step as failed, leaving its progress where it stopped
+func (r *EnhancedRenderer) FailStep(stepIndex int) {
+	if stepIndex >= 0 && stepIndex < len(r.steps) {
+		r.steps[stepIndex].Status = StepError
+	}
+}
+
 // SetCurrentStep sets which step is currently active
 func (r *EnhancedRenderer) SetCurrentStep(stepIndex int) {
 	r.currentStep = stepIndex
@@ -177,6 +448,10 @@ func (r *EnhancedRenderer) SetCurrentStep(stepIndex int) {
 // CompleteStep marks a step as complete
 func (r *EnhancedRenderer) CompleteStep(stepIndex int, duration time.Duration) {
 	if stepIndex >= 0 && stepIndex < len(r.steps) {
+		// A failed step stays failed when later steps advance past it
+		if r.steps[stepIndex].Status == StepError {
+			return
+		}
 		r.steps[stepIndex].Status = StepComplete
 		r.steps[stepIndex].Progress = 1.0
 		r.steps[stepIndex].Duration = duration
@@ -199,9 +474,37 @@ func (r *EnhancedRenderer) GetOverallProgress() float64 {
 // Render generates the comprehensive enhanced output
 func (r *EnhancedRenderer) Render(width int) string {
 	var output strings.Builder
+	r.RenderTo(&output, width)
+	return output.String()
+}
+
+// RenderTo writes the same frame as Render to w and returns the bytes written,
+// e.g. to mirror progress into a log file alongside the terminal
+func (r *EnhancedRenderer) RenderTo(w io.Writer, width int) (int, error) {
+	return io.WriteString(w, r.renderFrame(width))
+}
+
+// RenderPlain renders the frame with a colorless theme, so the layout and
+// column math can be checked without escape codes; it matches
+// StripANSI(Render(width)) byte for byte
+func (r *EnhancedRenderer) RenderPlain(width int) string {
+	theme := r.theme
+	r.SetTheme(styles.MonochromeTheme())
+	defer r.SetTheme(theme)
+	return r.Render(width)
+}
+
+// StripANSI removes ANSI escape sequences from s (see styles.StripANSI)
+func StripANSI(s string) string {
+	return styles.StripANSI(s)
+}
+
+// renderFrame builds one complete frame at the given width
+func (r *EnhancedRenderer) renderFrame(width int) string {
+	var output strings.Builder
 
 	// Store the width for consistent formatting
-	r.totalWidth = width
+	r.Resize(width)
 
 	// Header section (includes empty line between header and progress)
 	output.WriteString(r.renderHeader())
@@ -224,11 +527,26 @@ func (r *EnhancedRenderer) Render(width int) string {
 	output.WriteString(r.renderSeparatorLine())
 	output.WriteString("\n")
 
-	// Main steps section
-	for i, step := range r.steps {
+	// Main steps section, windowed around the current step when limited
+	start, end := r.visibleStepRange()
+	if start > 0 {
+		output.WriteString(fmt.Sprintf("%s… %d more above%s\n", r.theme.Dashes, start, r.theme.Reset))
+	}
+	for i := start; i < end; i++ {
+		step := r.steps[i]
 		stepLine := r.renderStepLine(i, step)
 		output.WriteString(stepLine)
 		output.WriteString("\n")
+
+		// Sub-steps for the running step
+		if r.showSubSteps && i == r.currentStep && step.Status == StepRunning {
+			for _, subStep := range step.SubSteps {
+				output.WriteString(fmt.Sprintf("     %s└ %s%s\n", r.theme.Dashes, subStep, r.theme.Reset))
+			}
+		}
+	}
+	if end < len(r.steps) {
+		output.WriteString(fmt.Sprintf("%s… %d more below%s\n", r.theme.Dashes, len(r.steps)-end, r.theme.Reset))
 	}
 
 	// Middle separator
@@ -241,17 +559,21 @@ func (r *EnhancedRenderer) Render(width int) string {
 
 	// Bottom separator
 	output.WriteString(r.renderSeparatorLine())
-	output.WriteString("\n")
 
-	// Empty line for breathing space (as shown in template)
-	output.WriteString("\n")
+	if r.shouldShow("components") {
+		// Empty line for breathing space (as shown in template)
+		output.WriteString("\n\n")
 
-	// Application Components section
-	output.WriteString(r.renderApplicationComponents())
+		// Application Components section
+		output.WriteString(r.renderApplicationComponents())
 
-	// Final separator
-	output.WriteString(r.renderSeparatorLine())
+		// Final separator
+		output.WriteString(r.renderSeparatorLine())
+	}
 
+	if !r.colorEnabled {
+		return styles.StripANSI(output.String())
+	}
 	return output.String()
 }
 
@@ -261,34 +583,26 @@ func (r *EnhancedRenderer) renderHeader() string {
 	var setupType, setupColor string
 	if r.isDevOnly {
 		setupType = "DEV SETUP"
-		setupColor = colorBlue
+		setupColor = r.theme.Running
 	} else {
 		setupType = "PRODUCTION READY SETUP"
-		setupColor = colorBrightOrange
+		setupColor = r.theme.Highlight
 	}
 
 	// Create colored header components
-	dashPrefix := fmt.Sprintf("%s---%s", colorLightGrey, colorReset)
-	coloredAppName := fmt.Sprintf("%s'%s'%s", colorBrightMagenta, r.appName, colorReset)
+	dashPrefix := r.dashRun(3)
+	coloredAppName := fmt.Sprintf("%s'%s'%s", r.theme.AppName, r.appName, r.theme.Reset)
 	creatingText := fmt.Sprintf(" Creating %s ", coloredAppName)
 
-	// Calculate padding for setup type (accounting for color codes in length calculation)
-	plainHeader := fmt.Sprintf("--- Creating '%s' ", r.appName)
-	plainSetupPadding := fmt.Sprintf(" %s ", setupType)
-	plainTotalLength := len(plainHeader) + len(plainSetupPadding) + 4 // 4 dashes at end
+	coloredSetupType := fmt.Sprintf(" %s%s%s ", setupColor, setupType, r.theme.Reset)
+	endDashes := r.dashRun(4)
 
-	var headerText string
-	if plainTotalLength < r.totalWidth {
-		middlePadding := r.totalWidth - len(plainHeader) - len(plainSetupPadding) - 4
-		middleDashes := fmt.Sprintf("%s%s%s", colorLightGrey, strings.Repeat("-", middlePadding), colorReset)
-		coloredSetupType := fmt.Sprintf(" %s%s%s ", setupColor, setupType, colorReset)
-		endDashes := fmt.Sprintf("%s----%s", colorLightGrey, colorReset)
-
-		headerText = dashPrefix + creatingText + middleDashes + coloredSetupType + endDashes
-	} else {
-		coloredSetupType := fmt.Sprintf(" %s%s%s ", setupColor, setupType, colorReset)
-		endDashes := fmt.Sprintf("%s----%s", colorLightGrey, colorReset)
-		headerText = dashPrefix + creatingText + coloredSetupType + endDashes
+	// Fill between the app name and setup type with dashes when there's room
+	left := dashPrefix + creatingText
+	right := coloredSetupType + endDashes
+	headerText := left + right
+	if middlePadding := r.totalWidth - styles.VisibleWidth(left) - styles.VisibleWidth(right); middlePadding > 0 {
+		headerText = left + r.dashRun(middlePadding) + right
 	}
 
 	// Total progress line with modular progress bar system
@@ -306,7 +620,7 @@ func (r *EnhancedRenderer) renderHeader() string {
 
 	// Use modular progress bar system
 	config := ProgressBarConfig{
-		Width:          47,        // Fixed width like original
+		Width:          r.totalProgressBarWidth(), // Scales with the terminal width
 		ShowPercentage: true,      // Show percentage
 		PercentagePad:  6,         // Right-align in 6 characters like original
 		FillMode:       false,     // Use fixed width
@@ -318,6 +632,25 @@ func (r *EnhancedRenderer) renderHeader() string {
 	return fmt.Sprintf("%s\n\n%s", headerText, progressText)
 }
 
+// Total Progress bar sizing: a share of the layout width, kept readable at the extremes
+const (
+	totalProgressBarPercent  = 53 // 47 columns at the 89-column template width
+	minTotalProgressBarWidth = 20
+	maxTotalProgressBarWidth = 80
+)
+
+// totalProgressBarWidth sizes the Total Progress bar for the current layout width
+func (r *EnhancedRenderer) totalProgressBarWidth() int {
+	width := r.totalWidth * totalProgressBarPercent / 100
+	if width < minTotalProgressBarWidth {
+		return minTotalProgressBarWidth
+	}
+	if width > maxTotalProgressBarWidth {
+		return maxTotalProgressBarWidth
+	}
+	return width
+}
+
 // renderCurrentStepInfo shows the current running step with colored spinner or completion
 func (r *EnhancedRenderer) renderCurrentStepInfo(step Step) string {
 	// Check if all steps are complete
@@ -328,11 +661,11 @@ func (r *EnhancedRenderer) renderCurrentStepInfo(step Step) string {
 	if allComplete {
 		// Show completion state with green color
 		message = "Completed Successfully"
-		statusText = fmt.Sprintf("%s✓ Done%s", colorGreen, colorReset)
+		statusText = fmt.Sprintf("%s%s Done%s", r.theme.Done, styles.CurrentGlyphs().Check, r.theme.Reset)
 	} else {
 		// Show running state with colored spinner
 		spinnerChars := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
-		spinnerIndex := int(time.Since(r.startTime)/time.Millisecond/100) % len(spinnerChars)
+		spinnerIndex := int(r.now().Sub(r.startTime)/time.Millisecond/100) % len(spinnerChars)
 		spinner := spinnerChars[spinnerIndex]
 
 		message = step.Message
@@ -344,38 +677,35 @@ func (r *EnhancedRenderer) renderCurrentStepInfo(step Step) string {
 		var spinnerColor string
 		switch step.Status {
 		case StepPending:
-			spinnerColor = colorWhite // Queued
+			spinnerColor = r.theme.Queued
 		case StepRunning:
-			spinnerColor = colorBlue // Running
+			spinnerColor = r.theme.Running // Running
 		case StepComplete:
-			spinnerColor = colorGreen // Done
+			spinnerColor = r.theme.Done // Done
 		case StepError:
-			spinnerColor = colorRed // Failed
+			spinnerColor = r.theme.Failed // Failed
 		default:
 			// Determine based on progress
 			if step.Progress >= 1.0 {
-				spinnerColor = colorGreen
+				spinnerColor = r.theme.Done
 			} else if step.Progress > 0 {
-				spinnerColor = colorBlue
+				spinnerColor = r.theme.Running
 			} else {
-				spinnerColor = colorWhite
+				spinnerColor = r.theme.Text
 			}
 		}
 
-		coloredSpinner := fmt.Sprintf("%s%s%s", spinnerColor, spinner, colorReset)
+		coloredSpinner := fmt.Sprintf("%s%s%s", spinnerColor, spinner, r.theme.Reset)
 		statusText = fmt.Sprintf("%s Running...", coloredSpinner)
 	}
 
-	// Calculate spacing to right-align the status (use plain text lengths for calculation)
-	plainStatusText := "✓ Done"   // Sample status for length calculation
-	maxMessageLength := r.totalWidth - len("Current Step: ") - len(plainStatusText) - 3
-
-	if len(message) > maxMessageLength {
-		message = message[:maxMessageLength-3] + "..."
+	// Truncate the message so the right-aligned status still fits
+	maxMessageLength := r.totalWidth - styles.VisibleWidth("Current Step: ") - styles.VisibleWidth(statusText) - 1
+	if maxMessageLength > 3 && runewidth.StringWidth(message) > maxMessageLength {
+		message = runewidth.Truncate(message, maxMessageLength, "...")
 	}
 
-	padding := maxMessageLength - len(message)
-	return fmt.Sprintf("Current Step: %s%s %s", message, strings.Repeat(" ", padding), statusText)
+	return styles.PadBetween("Current Step: "+message, statusText, r.totalWidth)
 }
 
 // renderStepLine creates a single aligned step line with dynamic width
@@ -386,6 +716,14 @@ func (r *EnhancedRenderer) renderStepLine(index int, step Step) string {
 
 	// Use modular progress state system
 	progressState := progressStateFromStepStatus(step.Status, step.Progress)
+	labelState := stepStatusToLabelState(step.Status)
+
+	// A step held up by a chaos delay hasn't really finished, even at 100%
+	if index == r.stallingStep && step.Status != StepError {
+		icon = r.renderStatusIcon(IconRunning)
+		progressState = StateStalling
+		labelState = LabelPaused
+	}
 
 	// Use modular progress bar system with fixed max width
 	config := ProgressBarConfig{
@@ -397,18 +735,27 @@ func (r *EnhancedRenderer) renderStepLine(index int, step Step) string {
 	progressResult := r.renderModularProgressBar(step.Progress, progressState, config)
 
 	// Calculate width usage for layout
-	plainIcon := "[ ]" // Use plain icon for width calculation (3 chars)
-	usedWidth := len(plainIcon) + 1 // icon + space
-	totalProgressWidth := progressResult.PlainBarWidth + 1 + progressResult.PlainPercWidth // bar + space + percentage
+	usedWidth := styles.VisibleWidth(icon) + 1 // icon + space
+	totalProgressWidth := styles.VisibleWidth(progressResult.Combined) // bar + space + percentage
 	stepNameWidth := r.totalWidth - usedWidth - totalProgressWidth - 1 // -1 for space before progress
 
+	// Reserve the duration column on every line so the bars stay aligned
+	var durationText string
+	if r.showStepDurations {
+		stepNameWidth -= stepDurationWidth + 1
+		durationText = strings.Repeat(" ", stepDurationWidth)
+		if step.Status == StepComplete {
+			durationText = fmt.Sprintf("%s%*s%s", r.theme.Dashes, stepDurationWidth, formatStepDuration(step.Duration), r.theme.Reset)
+		}
+		durationText = " " + durationText
+	}
+
 	// Ensure minimum width and bounds checking
 	if stepNameWidth < 5 { // Minimum of 5 characters for step name
 		stepNameWidth = 5
 	}
 
 	// Use modular step label system
-	labelState := stepStatusToLabelState(step.Status)
 	labelConfig := StepLabelConfig{
 		BaseText:         step.Name,
 		ShowEllipsis:     true, // Show "..." for in-progress steps
@@ -417,8 +764,8 @@ func (r *EnhancedRenderer) renderStepLine(index int, step Step) string {
 	}
 	labelResult := r.renderModularStepLabel(labelState, labelConfig)
 
-	// Pad styled step name to calculated width (using plain text length for padding calculation)
-	paddingNeeded := stepNameWidth - labelResult.ActualWidth
+	// Pad styled step name to calculated width
+	paddingNeeded := stepNameWidth - styles.VisibleWidth(labelResult.StyledText)
 	var stepNamePadded string
 	if paddingNeeded > 0 {
 		stepNamePadded = labelResult.StyledText + strings.Repeat(" ", paddingNeeded)
@@ -427,7 +774,18 @@ func (r *EnhancedRenderer) renderStepLine(index int, step Step) string {
 	}
 
 	// Combine with proper spacing
-	return fmt.Sprintf("%s %s %s", icon, stepNamePadded, progressResult.Combined)
+	return fmt.Sprintf("%s %s %s%s", icon, stepNamePadded, progressResult.Combined, durationText)
+}
+
+// stepDurationWidth is the column reserved for a completed step's duration
+const stepDurationWidth = 6
+
+// formatStepDuration formats a step duration compactly, e.g. "3.0s" or "1m 05s"
+func formatStepDuration(d time.Duration) string {
+	if d < time.Minute {
+		return fmt.Sprintf("%.1fs", d.Seconds())
+	}
+	return fmt.Sprintf("%dm %02ds", int(d.Minutes()), int(d.Seconds())%60)
 }
 
 // renderFooterInfo creates the footer with timing and directory info
@@ -437,38 +795,41 @@ func (r *EnhancedRenderer) renderFooterInfo() string {
 	switch strings.ToLower(r.template) {
 	case "typescript":
 		templateDisplay = "TypeScript"
-		templateColor = colorBlue // Blue for TypeScript
+		templateColor = r.theme.Running // Blue for TypeScript
 	case "javascript":
 		templateDisplay = "JavaScript"
-		templateColor = colorYellow // Yellow for JavaScript
+		templateColor = r.theme.Warning // Yellow for JavaScript
 	default:
 		templateDisplay = r.template
-		templateColor = colorWhite // Default white
+		templateColor = r.theme.Text // Default white
 	}
 
 	// Color the directory path bright magenta
-	coloredTargetDir := fmt.Sprintf("%s%s%s", colorBrightMagenta, r.targetDir, colorReset)
-	coloredTemplate := fmt.Sprintf("%s%s%s", templateColor, templateDisplay, colorReset)
+	coloredTargetDir := fmt.Sprintf("%s%s%s", r.theme.AppName, r.targetDir, r.theme.Reset)
+	coloredTemplate := fmt.Sprintf("%s%s%s", templateColor, templateDisplay, r.theme.Reset)
 
-	// Calculate padding accounting for the original text length (without color codes)
-	plainLine1 := fmt.Sprintf("Target Directory: %s", r.targetDir)
-	plainTemplatePart := templateDisplay
-	padding1 := r.totalWidth - len(plainLine1) - len(plainTemplatePart)
-
-	var line1 string
-	if padding1 > 0 {
-		line1 = fmt.Sprintf("Target Directory: %s%s%s", coloredTargetDir, strings.Repeat(" ", padding1), coloredTemplate)
-	} else {
-		line1 = fmt.Sprintf("Target Directory: %s %s", coloredTargetDir, coloredTemplate)
+	line1 := styles.PadBetween("Target Directory: "+coloredTargetDir, coloredTemplate, r.totalWidth)
+	if !r.shouldShow("timings") {
+		return line1
 	}
 
 	// Second line: Timing information
-	elapsed := time.Since(r.startTime)
+	elapsed := r.now().Sub(r.startTime)
+	if r.timing != nil {
+		elapsed = r.timing.TotalElapsed()
+	}
 	elapsedFormatted := formatDuration(elapsed)
 
 	// Estimate remaining time based on progress
 	var estimatedRemaining string
-	if progress := r.GetOverallProgress(); progress > 0 && progress < 1.0 {
+	if progress := r.GetOverallProgress(); progress >= 1.0 {
+		estimatedRemaining = "00h 00m 00s"
+	} else if r.timing != nil && progress <= 0 {
+		// Nothing has run yet, so the whole planned duration is the baseline
+		estimatedRemaining = formatDuration(r.timing.TotalEstimatedDuration())
+	} else if r.timing != nil {
+		estimatedRemaining = formatDuration(r.timing.EstimatedTimeRemaining())
+	} else if progress > 0 {
 		totalEstimated := time.Duration(float64(elapsed) / progress)
 		remaining := totalEstimated - elapsed
 		estimatedRemaining = formatDuration(remaining)
@@ -478,9 +839,8 @@ func (r *EnhancedRenderer) renderFooterInfo() string {
 
 	line2Left := fmt.Sprintf("Estimated Time Remaining: %s", estimatedRemaining)
 	line2Right := fmt.Sprintf("Elapsed Time: %s", elapsedFormatted)
-	padding2 := r.totalWidth - len(line2Left) - len(line2Right)
-	if padding2 > 0 {
-		line2 := line2Left + strings.Repeat(" ", padding2) + line2Right
+	if styles.VisibleWidth(line2Left)+styles.VisibleWidth(line2Right) < r.totalWidth {
+		line2 := styles.PadBetween(line2Left, line2Right, r.totalWidth)
 		return fmt.Sprintf("%s\n%s", line1, line2)
 	}
 
@@ -493,17 +853,17 @@ func (r *EnhancedRenderer) renderApplicationComponents() string {
 
 	// Section header - white title with grey dashes
 	headerText := "---- APPLICATION COMPONENTS "
-	padding := r.totalWidth - len(headerText)
+	padding := r.totalWidth - styles.VisibleWidth(headerText)
 	var fullHeaderText string
 	if padding > 0 {
-		paddingDashes := fmt.Sprintf("%s%s%s", colorLightGrey, strings.Repeat("-", padding), colorReset)
+		paddingDashes := r.dashRun(padding)
 		// Use grey for dashes but white for title
-		dashPrefix := fmt.Sprintf("%s----%s", colorLightGrey, colorReset)
-		whiteTitle := fmt.Sprintf("%s APPLICATION COMPONENTS %s", colorWhite, colorReset)
+		dashPrefix := r.dashRun(4)
+		whiteTitle := fmt.Sprintf("%s APPLICATION COMPONENTS %s", r.theme.Text, r.theme.Reset)
 		fullHeaderText = dashPrefix + whiteTitle + paddingDashes
 	} else {
-		dashPrefix := fmt.Sprintf("%s----%s", colorLightGrey, colorReset)
-		whiteTitle := fmt.Sprintf("%s APPLICATION COMPONENTS %s", colorWhite, colorReset)
+		dashPrefix := r.dashRun(4)
+		whiteTitle := fmt.Sprintf("%s APPLICATION COMPONENTS %s", r.theme.Text, r.theme.Reset)
 		fullHeaderText = dashPrefix + whiteTitle
 	}
 	output.WriteString(fullHeaderText + "\n")
@@ -567,29 +927,8 @@ func (r *EnhancedRenderer) renderComponentLine(component Component) string {
 		icon = component.Icon
 	}
 
-	// Calculate dynamic width for proper right alignment
-	// Note: we need to calculate the plain text length for proper alignment since statusDisplay contains color codes
-	var plainStatusLength int
-	switch componentState {
-	case ComponentQueued:
-		plainStatusLength = len("[queued]")
-	case ComponentInstalling:
-		plainStatusLength = len("[installing...]")
-	case ComponentSkipped:
-		plainStatusLength = len("[skipped]")
-	case ComponentInstalled:
-		plainStatusLength = len("[installed]")
-	case ComponentFailed:
-		plainStatusLength = len("[failed]")
-	default:
-		plainStatusLength = len("[queued]")
-	}
-
-	// Calculate icon width for plain text (without color codes)
-	plainIconLength := 3 // "[ ]", "[✓]", "[✗]" are all 3 characters
-
 	// Total width - indent - icon - spaces - status = remaining for component name
-	remainingWidth := r.totalWidth - 2 - plainIconLength - 1 - plainStatusLength - 1
+	remainingWidth := r.totalWidth - 2 - styles.VisibleWidth(icon) - 1 - styles.VisibleWidth(statusDisplay) - 1
 
 	// Use modular step label system for component names
 	labelState := componentStatusToLabelState(component.Status)
@@ -601,17 +940,8 @@ func (r *EnhancedRenderer) renderComponentLine(component Component) string {
 	}
 	labelResult := r.renderModularStepLabel(labelState, labelConfig)
 
-	// Pad styled component name to calculated width (using plain text length for padding calculation)
-	paddingNeeded := remainingWidth - labelResult.ActualWidth
-	var componentNamePadded string
-	if paddingNeeded > 0 {
-		componentNamePadded = labelResult.StyledText + strings.Repeat(" ", paddingNeeded)
-	} else {
-		componentNamePadded = labelResult.StyledText
-	}
-
-	// Combine with proper spacing
-	return fmt.Sprintf("  %s %s %s\n", icon, componentNamePadded, statusDisplay)
+	// Right-align the status against the full line width
+	return styles.PadBetween(fmt.Sprintf("  %s %s", icon, labelResult.StyledText), statusDisplay, r.totalWidth) + "\n"
 }
 
 // ProgressState represents different states for progress visualization
@@ -632,6 +962,7 @@ func (r *EnhancedRenderer) renderProgressBar(progress float64, width int) string
 
 // renderProgressBarWithState creates a colored progress bar with specific state
 func (r *EnhancedRenderer) renderProgressBarWithState(progress float64, width int, state ProgressState) string {
+	progress = clampProgress(progress)
 	filled := int(progress * float64(width))
 	empty := width - filled
 
@@ -646,28 +977,28 @@ func (r *EnhancedRenderer) renderProgressBarWithState(progress float64, width in
 	var barColor string
 	switch state {
 	case StateQueued:
-		barColor = colorWhite
+		barColor = r.theme.Queued
 	case StateRunning:
-		barColor = colorBlue
+		barColor = r.theme.Running
 	case StateStalling:
-		barColor = colorYellow
+		barColor = r.theme.Warning
 	case StateFailed:
-		barColor = colorRed
+		barColor = r.theme.Failed
 	case StateDone:
-		barColor = colorGreen
+		barColor = r.theme.Done
 	default:
 		// If progress is 100%, use green regardless of state
 		if progress >= 1.0 {
-			barColor = colorGreen
+			barColor = r.theme.Done
 		} else if progress > 0 {
-			barColor = colorBlue
+			barColor = r.theme.Running
 		} else {
-			barColor = colorWhite
+			barColor = r.theme.Queued
 		}
 	}
 
 	// Create colored progress bar
-	coloredFilled := fmt.Sprintf("%s%s%s", barColor, strings.Repeat("#", filled), colorReset)
+	coloredFilled := fmt.Sprintf("%s%s%s", barColor, strings.Repeat("#", filled), r.theme.Reset)
 	emptySpace := strings.Repeat(" ", empty)
 
 	return fmt.Sprintf("[%s%s]", coloredFilled, emptySpace)
@@ -675,6 +1006,8 @@ func (r *EnhancedRenderer) renderProgressBarWithState(progress float64, width in
 
 // renderColoredPercentage colors percentage text to match progress bar state
 func (r *EnhancedRenderer) renderColoredPercentage(progress float64, state ProgressState) string {
+	progress = clampProgress(progress)
+
 	// Calculate percentage with proper rounding
 	percentage := progress * 100
 	var percentText string
@@ -693,26 +1026,26 @@ func (r *EnhancedRenderer) renderColoredPercentage(progress float64, state Progr
 	var percentColor string
 	switch state {
 	case StateQueued:
-		percentColor = colorWhite
+		percentColor = r.theme.Queued
 	case StateRunning:
-		percentColor = colorBlue
+		percentColor = r.theme.Running
 	case StateStalling:
-		percentColor = colorYellow
+		percentColor = r.theme.Warning
 	case StateFailed:
-		percentColor = colorRed
+		percentColor = r.theme.Failed
 	case StateDone:
-		percentColor = colorGreen
+		percentColor = r.theme.Done
 	default:
 		if progress >= 1.0 {
-			percentColor = colorGreen
+			percentColor = r.theme.Done
 		} else if progress > 0 {
-			percentColor = colorBlue
+			percentColor = r.theme.Running
 		} else {
-			percentColor = colorWhite
+			percentColor = r.theme.Queued
 		}
 	}
 
-	return fmt.Sprintf("%s%s%s", percentColor, percentText, colorReset)
+	return fmt.Sprintf("%s%s%s", percentColor, percentText, r.theme.Reset)
 }
 
 // StatusIconType represents different types of status indicators
@@ -729,26 +1062,27 @@ const (
 // renderStatusIcon creates a colored status icon based on type
 func (r *EnhancedRenderer) renderStatusIcon(iconType StatusIconType) string {
 	var icon, color string
+	glyphs := styles.CurrentGlyphs()
 
 	switch iconType {
 	case IconPending, IconQueued:
-		icon = "[ ]"
-		color = colorWhite
+		icon = glyphs.Pending
+		color = r.theme.Queued
 	case IconRunning:
-		icon = "[✓ ]" // Space after checkmark for running steps
-		color = colorBlue
+		icon = glyphs.Running
+		color = r.theme.Running
 	case IconComplete:
-		icon = "[✓]"
-		color = colorGreen
+		icon = glyphs.Complete
+		color = r.theme.Done
 	case IconError:
-		icon = "[✗]"
-		color = colorRed
+		icon = glyphs.Error
+		color = r.theme.Failed
 	default:
-		icon = "[ ]"
-		color = colorWhite
+		icon = glyphs.Pending
+		color = r.theme.Queued
 	}
 
-	return fmt.Sprintf("%s%s%s", color, icon, colorReset)
+	return fmt.Sprintf("%s%s%s", color, icon, r.theme.Reset)
 }
 
 // statusIconTypeFromStepStatus converts step status to icon type
@@ -782,8 +1116,6 @@ type ProgressBarResult struct {
 	ProgressBar     string // The rendered progress bar [####    ]
 	Percentage      string // The colored percentage text
 	Combined        string // Bar + percentage combined with proper spacing
-	PlainBarWidth   int    // Actual width of the progress bar (without colors)
-	PlainPercWidth  int    // Actual width of percentage text (without colors)
 }
 
 // StepLabelState represents different states for step/component labels
@@ -809,8 +1141,6 @@ type StepLabelConfig struct {
 // StepLabelResult contains the rendered step label and metadata
 type StepLabelResult struct {
 	StyledText    string // The fully styled text with colors and formatting
-	PlainText     string // The plain text without styling
-	ActualWidth   int    // The actual character width of the plain text
 }
 
 // renderModularStepLabel creates a styled step/component label based on state
@@ -820,34 +1150,34 @@ func (r *EnhancedRenderer) renderModularStepLabel(state StepLabelState, config S
 	// Determine styling based on state
 	switch state {
 	case LabelQueued:
-		color = colorWhite
+		color = r.theme.Text
 		style = ""
 		suffix = ""
 	case LabelInProgress:
-		color = colorBlue
-		style = styleItalic
+		color = r.theme.Running
+		style = r.theme.Italic
 		suffix = ""
 		if config.ShowEllipsis {
 			suffix = "..."
 		}
 	case LabelPaused:
-		color = colorYellow
+		color = r.theme.Warning
 		style = ""
 		suffix = ""
 	case LabelFailed:
-		color = colorRed
+		color = r.theme.Failed
 		style = ""
 		suffix = ""
 	case LabelSuccess:
-		color = colorGreen
+		color = r.theme.Done
 		style = ""
 		suffix = ""
 	case LabelSkipped:
-		color = colorLightGrey
-		style = styleItalic
+		color = r.theme.Skipped
+		style = r.theme.Italic
 		suffix = ""
 	default:
-		color = colorWhite
+		color = r.theme.Text
 		style = ""
 		suffix = ""
 	}
@@ -856,26 +1186,24 @@ func (r *EnhancedRenderer) renderModularStepLabel(state StepLabelState, config S
 	displayText := config.BaseText + suffix
 
 	// Handle width truncation if specified
-	if config.MaxWidth > 0 && len(displayText) > config.MaxWidth {
+	if config.MaxWidth > 0 && runewidth.StringWidth(displayText) > config.MaxWidth {
 		if config.TruncateEllipsis && config.MaxWidth > 3 {
-			displayText = displayText[:config.MaxWidth-3] + "..."
+			displayText = runewidth.Truncate(displayText, config.MaxWidth, "...")
 		} else {
-			displayText = displayText[:config.MaxWidth]
+			displayText = runewidth.Truncate(displayText, config.MaxWidth, "")
 		}
 	}
 
 	// Apply styling
 	var styledText string
 	if style != "" {
-		styledText = fmt.Sprintf("%s%s%s%s%s", color, style, displayText, styleReset, colorReset)
+		styledText = fmt.Sprintf("%s%s%s%s%s", color, style, displayText, r.theme.NormalIntensity, r.theme.Reset)
 	} else {
-		styledText = fmt.Sprintf("%s%s%s", color, displayText, colorReset)
+		styledText = fmt.Sprintf("%s%s%s", color, displayText, r.theme.Reset)
 	}
 
 	return StepLabelResult{
-		StyledText:  styledText,
-		PlainText:   displayText,
-		ActualWidth: len(displayText),
+		StyledText: styledText,
 	}
 }
 
@@ -940,32 +1268,25 @@ func (r *EnhancedRenderer) renderModularProgressBar(progress float64, state Prog
 	// Render percentage if requested
 	var percentage string
 	var combined string
-	var plainPercWidth int
 
 	if config.ShowPercentage {
 		percentage = r.renderColoredPercentage(progress, state)
-		// Calculate plain text width for percentage
-		plainPercText := fmt.Sprintf("%.1f%%", progress*100)
-		if plainPercText == "100%" {
-			plainPercText = "100.0%"
-		}
-		plainPercWidth = len(plainPercText)
 
 		// Combine with proper padding
-		paddedPercentage := fmt.Sprintf("%*s", config.PercentagePad, percentage)
+		paddedPercentage := percentage
+		if pad := config.PercentagePad - styles.VisibleWidth(percentage); pad > 0 {
+			paddedPercentage = strings.Repeat(" ", pad) + percentage
+		}
 		combined = fmt.Sprintf("%s %s", progressBar, paddedPercentage)
 	} else {
 		percentage = ""
 		combined = progressBar
-		plainPercWidth = 0
 	}
 
 	return ProgressBarResult{
 		ProgressBar:    progressBar,
 		Percentage:     percentage,
 		Combined:       combined,
-		PlainBarWidth:  barWidth + 2, // +2 for [ ]
-		PlainPercWidth: plainPercWidth,
 	}
 }
 
@@ -977,35 +1298,35 @@ func (r *EnhancedRenderer) renderComponentInstallationStatus(state ComponentInst
 	switch state {
 	case ComponentQueued:
 		status = "[queued]"
-		color = colorWhite
+		color = r.theme.Text
 		style = ""
 	case ComponentInstalling:
 		status = "[installing...]"
-		color = colorBlue
+		color = r.theme.Running
 		style = ""
 	case ComponentSkipped:
 		status = "[skipped]"
-		color = colorLightGrey
-		style = styleItalic
+		color = r.theme.Skipped
+		style = r.theme.Italic
 	case ComponentInstalled:
 		status = "[installed]"
-		color = colorGreen
+		color = r.theme.Done
 		style = ""
 	case ComponentFailed:
 		status = "[failed]"
-		color = colorRed
+		color = r.theme.Failed
 		style = ""
 	default:
 		status = "[queued]"
-		color = colorWhite
+		color = r.theme.Text
 		style = ""
 	}
 
 	// Apply both color and style if present
 	if style != "" {
-		return fmt.Sprintf("%s%s%s%s%s", color, style, status, styleReset, colorReset)
+		return fmt.Sprintf("%s%s%s%s%s", color, style, status, r.theme.NormalIntensity, r.theme.Reset)
 	}
-	return fmt.Sprintf("%s%s%s", color, status, colorReset)
+	return fmt.Sprintf("%s%s%s", color, status, r.theme.Reset)
 }
 
 // componentStateFromStatus converts string status to ComponentInstallationState
@@ -1093,6 +1414,21 @@ func (r *EnhancedRenderer) UpdateComponentStatuses(stepName string, stepProgress
 	}
 }
 
+// ComponentStatuses reports every component's status by name
+func (r *EnhancedRenderer) ComponentStatuses() map[string]string {
+	statuses := make(map[string]string)
+	for _, component := range r.coreTechnologies {
+		statuses[component.Name] = component.Status
+	}
+	for _, component := range r.engxIntegrations {
+		statuses[component.Name] = component.Status
+	}
+	for _, component := range r.qualityComponents {
+		statuses[component.Name] = component.Status
+	}
+	return statuses
+}
+
 // applyComponentUpdate applies a component update to the appropriate section
 func (r *EnhancedRenderer) applyComponentUpdate(update ComponentUpdate) {
 	// Try to update in core technologies
@@ -1192,4 +1528,15 @@ func (r *EnhancedRenderer) updateQualityComponentStatus(name, status string) {
 			break
 		}
 	}
-}
\ No newline at end of file
+}
+
+// clampProgress bounds progress to [0,1] so bar and percentage math can't overshoot
+func clampProgress(progress float64) float64 {
+	if progress < 0 {
+		return 0
+	}
+	if progress > 1 {
+		return 1
+	}
+	return progress
+}
@@ -0,0 +1,665 @@
+package components
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+var testStepNames = []string{
+	"Initializing project",
+	"Installing dependencies",
+	"Configuring development environment",
+}
+
+// newTestRenderer builds a colorless renderer over testStepNames
+func newTestRenderer() *EnhancedRenderer {
+	return NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", testStepNames, true, false)
+}
+
+func TestSeparatorLineCachedPerWidth(t *testing.T) {
+	r := newTestRenderer()
+	r.Resize(60)
+
+	first := r.renderSeparatorLine()
+	if got := StripANSI(first); got != strings.Repeat("-", 60) {
+		t.Fatalf("separator = %q, want 60 dashes", got)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		r.renderSeparatorLine()
+	})
+	if allocs != 0 {
+		t.Errorf("cached separator allocated %.0f times per call, want 0", allocs)
+	}
+
+	r.Resize(40)
+	if got := StripANSI(r.renderSeparatorLine()); got != strings.Repeat("-", 40) {
+		t.Errorf("separator after Resize(40) = %q, want 40 dashes", got)
+	}
+}
+
+func BenchmarkSeparatorLine(b *testing.B) {
+	r := newTestRenderer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.renderSeparatorLine()
+	}
+}
+
+func BenchmarkRender(b *testing.B) {
+	r := newTestRenderer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.Render(89)
+	}
+}
+
+func TestProgressBarClampsOvershoot(t *testing.T) {
+	r := newTestRenderer()
+
+	tests := []struct {
+		progress    float64
+		wantBar     string
+		wantPercent string
+	}{
+		{1.0000001, "[" + strings.Repeat("#", 20) + "]", "100.0%"},
+		{-0.0000001, "[" + strings.Repeat(" ", 20) + "]", "0.0%"},
+		{0.5, "[" + strings.Repeat("#", 10) + strings.Repeat(" ", 10) + "]", "50.0%"},
+	}
+
+	for _, tt := range tests {
+		bar := StripANSI(r.renderProgressBarWithState(tt.progress, 20, StateRunning))
+		if bar != tt.wantBar {
+			t.Errorf("bar(%v) = %q, want %q", tt.progress, bar, tt.wantBar)
+		}
+		if percent := StripANSI(r.renderColoredPercentage(tt.progress, StateRunning)); !strings.Contains(percent, tt.wantPercent) {
+			t.Errorf("percentage(%v) = %q, want %q", tt.progress, percent, tt.wantPercent)
+		}
+	}
+}
+
+// qualityNames lists the renderer's quality section component names
+func qualityNames(r *EnhancedRenderer) []string {
+	names := make([]string, len(r.qualityComponents))
+	for i, component := range r.qualityComponents {
+		names[i] = component.Name
+	}
+	return names
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestQualityComponentsFollowTestingConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		testing config.TestingConfig
+		want    []string
+		absent  []string
+	}{
+		{
+			name:    "unit only",
+			testing: config.TestingConfig{UnitTesting: true},
+			want:    []string{"Vitest"},
+			absent:  []string{"Vitest Coverage (v8)", "Playwright (E2E)"},
+		},
+		{
+			name:    "e2e adds playwright",
+			testing: config.TestingConfig{UnitTesting: true, E2ETesting: true},
+			want:    []string{"Vitest", "Playwright (E2E)"},
+			absent:  []string{"Vitest Coverage (v8)"},
+		},
+		{
+			name:    "coverage adds v8 coverage",
+			testing: config.TestingConfig{UnitTesting: true, Coverage: true},
+			want:    []string{"Vitest", "Vitest Coverage (v8)"},
+			absent:  []string{"Playwright (E2E)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRenderer()
+			r.SetTestingConfig(tt.testing)
+			names := qualityNames(r)
+
+			for _, name := range tt.want {
+				if !containsName(names, name) {
+					t.Errorf("quality components %v missing %q", names, name)
+				}
+			}
+			for _, name := range tt.absent {
+				if containsName(names, name) {
+					t.Errorf("quality components %v unexpectedly include %q", names, name)
+				}
+			}
+			if !containsName(names, "EngX TypeScript Linters") {
+				t.Errorf("quality components %v dropped the linters", names)
+			}
+		})
+	}
+}
+
+func TestSubStepsToggleIndependentOfVerbosity(t *testing.T) {
+	verbose := config.NewVerbosityConfig(config.VerbosityVerbose)
+	verbose.ShowSubSteps = false
+
+	r := newTestRenderer()
+	r.SetVerbosity(verbose)
+	r.SetShowStepDurations(verbose.ShouldShowDetailLevel(4))
+	r.UpdateStep(0, 1.0, "Initialized", nil)
+	r.SetCurrentStep(1)
+	r.UpdateStep(1, 0.5, "Installing", []string{"Resolving packages", "Fetching tarballs"})
+
+	r.SetShowSubSteps(true)
+	withSubSteps := r.Render(80)
+	r.SetShowSubSteps(verbose.ShouldShow("substeps"))
+	without := r.Render(80)
+
+	for _, subStep := range []string{"Resolving packages", "Fetching tarballs"} {
+		if !strings.Contains(withSubSteps, subStep) {
+			t.Errorf("sub-step %q missing when sub-steps are shown", subStep)
+		}
+		if strings.Contains(without, subStep) {
+			t.Errorf("sub-step %q shown with sub-steps hidden", subStep)
+		}
+	}
+
+	// Everything else in the verbose frame is unchanged
+	var kept []string
+	for _, line := range strings.Split(withSubSteps, "\n") {
+		if !strings.Contains(line, "└ ") {
+			kept = append(kept, line)
+		}
+	}
+	if got := strings.Join(kept, "\n"); got != without {
+		t.Errorf("hiding sub-steps changed other output:\n got: %s\nwant: %s", without, got)
+	}
+}
+
+func TestSeparatorLineUsesConfiguredRune(t *testing.T) {
+	r := newTestRenderer()
+	r.Resize(60)
+	r.SetSeparator('─')
+
+	line := r.renderSeparatorLine()
+	if got := StripANSI(line); got != strings.Repeat("─", 60) {
+		t.Errorf("separator = %q, want 60 box-drawing runes", got)
+	}
+	if got := styles.VisibleWidth(line); got != 60 {
+		t.Errorf("separator visible width = %d, want 60", got)
+	}
+}
+
+func TestFooterElapsedFollowsTracker(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	tracker := progress.NewCreateTracker(true)
+	tracker.SetClock(func() time.Time { return now })
+	tracker.Start()
+
+	r := newTestRenderer()
+	r.Resize(100)
+	// The renderer's own clock has drifted well away from the tracker's
+	r.SetClock(func() time.Time { return start.Add(-time.Hour) })
+	r.SetTracker(tracker)
+
+	now = start.Add(65 * time.Second)
+
+	footer := StripANSI(r.renderFooterInfo())
+	want := "Elapsed Time: " + formatDuration(tracker.TotalElapsed())
+	if !strings.Contains(footer, want) {
+		t.Errorf("footer = %q, want %q", footer, want)
+	}
+	if !strings.Contains(footer, "00h 01m 05s") {
+		t.Errorf("footer = %q, want the tracker's 65s elapsed", footer)
+	}
+}
+
+func TestFooterETABaselineIsPlannedDuration(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tracker := progress.NewCreateTracker(true)
+	tracker.SetClock(func() time.Time { return start })
+	tracker.Start()
+
+	r := newTestRenderer()
+	r.Resize(100)
+	r.SetTracker(tracker)
+
+	footer := StripANSI(r.renderFooterInfo())
+	want := "Estimated Time Remaining: " + formatDuration(tracker.TotalEstimatedDuration())
+	if !strings.Contains(footer, want) {
+		t.Errorf("footer = %q, want %q", footer, want)
+	}
+}
+
+func TestMaxVisibleStepsWindow(t *testing.T) {
+	names := make([]string, 20)
+	for i := range names {
+		names[i] = fmt.Sprintf("Step number %02d", i+1)
+	}
+	r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", names, true, false)
+	r.SetMaxVisibleSteps(5)
+
+	tests := []struct {
+		current      int
+		above, below string
+		visible      []int
+	}{
+		{0, "", "… 15 more below", []int{0, 4}},
+		{10, "… 8 more above", "… 7 more below", []int{8, 12}},
+		{19, "… 15 more above", "", []int{15, 19}},
+	}
+
+	for _, tt := range tests {
+		r.SetCurrentStep(tt.current)
+		frame := StripANSI(r.Render(100))
+
+		if !strings.Contains(frame, names[tt.current]) {
+			t.Errorf("current step %d: %q not visible", tt.current, names[tt.current])
+		}
+		for _, indicator := range []string{tt.above, tt.below} {
+			if indicator != "" && !strings.Contains(frame, indicator) {
+				t.Errorf("current step %d: missing %q", tt.current, indicator)
+			}
+		}
+		if tt.above == "" && strings.Contains(frame, "more above") {
+			t.Errorf("current step %d: unexpected above indicator", tt.current)
+		}
+		if tt.below == "" && strings.Contains(frame, "more below") {
+			t.Errorf("current step %d: unexpected below indicator", tt.current)
+		}
+
+		shown := 0
+		for i, name := range names {
+			if strings.Contains(frame, name) {
+				shown++
+				if i < tt.visible[0] || i > tt.visible[1] {
+					t.Errorf("current step %d: step %d outside the window is shown", tt.current, i)
+				}
+			}
+		}
+		if shown != 5 {
+			t.Errorf("current step %d: %d steps shown, want 5", tt.current, shown)
+		}
+	}
+}
+
+// totalBarWidth measures the rendered Total Progress bar between its brackets
+func totalBarWidth(t *testing.T, r *EnhancedRenderer) int {
+	t.Helper()
+	header := StripANSI(r.renderHeader())
+	start, end := strings.Index(header, "["), strings.LastIndex(header, "]")
+	if start < 0 || end < start {
+		t.Fatalf("header has no Total Progress bar:\n%s", header)
+	}
+	return end - start - 1
+}
+
+func TestTotalProgressBarScalesWithWidth(t *testing.T) {
+	r := newTestRenderer()
+
+	r.Resize(60)
+	narrow := totalBarWidth(t, r)
+	r.Resize(120)
+	wide := totalBarWidth(t, r)
+
+	if wide <= narrow {
+		t.Errorf("bar width at 120 columns = %d, want it wider than %d at 60", wide, narrow)
+	}
+	if narrow >= 60 || wide >= 120 {
+		t.Errorf("bar widths %d/%d overflow their 60/120-column layouts", narrow, wide)
+	}
+
+	for _, tt := range []struct {
+		width int
+		want  int
+	}{
+		{width: 10, want: minTotalProgressBarWidth},
+		{width: 400, want: maxTotalProgressBarWidth},
+	} {
+		r.Resize(tt.width)
+		if got := r.totalProgressBarWidth(); got != tt.want {
+			t.Errorf("totalProgressBarWidth() at %d columns = %d, want %d", tt.width, got, tt.want)
+		}
+	}
+}
+
+// lineWith returns the first line of s containing substr
+func lineWith(s, substr string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if strings.Contains(line, substr) {
+			return line
+		}
+	}
+	return ""
+}
+
+// setComponentStatuses forces components into the given statuses, bypassing
+// the guard that keeps installed components from going back to installing
+func setComponentStatuses(r *EnhancedRenderer, statuses map[string]string) {
+	for name, status := range statuses {
+		r.applyComponentUpdate(ComponentUpdate{ComponentName: name, NewStatus: status})
+	}
+}
+
+func TestStepLinesAlignWithWideRunes(t *testing.T) {
+	r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", []string{"初始化项目", "Installing dependencies"}, true, true)
+	r.Resize(89)
+
+	view := r.Render(89)
+	if !strings.Contains(view, "\x1b[") {
+		t.Fatal("colored renderer produced no ANSI codes")
+	}
+	var widths []int
+	for _, name := range []string{"初始化项目", "Installing dependencies"} {
+		widths = append(widths, styles.VisibleWidth(lineWith(view, name)))
+	}
+	if widths[0] != widths[1] || widths[0] > 89 {
+		t.Errorf("colored step line widths = %v, want equal widths within 89 columns", widths)
+	}
+}
+
+func TestASCIIGlyphsReplaceEveryCheckmark(t *testing.T) {
+	styles.ApplyGlyphMode(styles.GlyphASCII)
+	t.Cleanup(func() { styles.ApplyGlyphMode(styles.GlyphUnicode) })
+
+	r := newTestRenderer()
+	statuses := r.ComponentStatuses()
+	for name := range statuses {
+		statuses[name] = "installed"
+	}
+	setComponentStatuses(r, statuses)
+	r.CompleteStep(0, time.Second)
+	r.FailStep(1)
+
+	view := StripANSI(r.Render(89))
+	for _, glyph := range []string{"✓", "✗"} {
+		if strings.Contains(view, glyph) {
+			t.Errorf("ascii render contains %q:\n%s", glyph, view)
+		}
+	}
+	if !strings.Contains(view, "[x]") {
+		t.Errorf("ascii render has no [x] for installed components:\n%s", view)
+	}
+	if !strings.Contains(view, "[!]") {
+		t.Errorf("ascii render has no [!] for the failed step:\n%s", view)
+	}
+
+	done := newTestRenderer()
+	for i := range testStepNames {
+		done.CompleteStep(i, time.Second)
+	}
+	if view := StripANSI(done.Render(89)); !strings.Contains(view, "x Done") || strings.Contains(view, "✓") {
+		t.Errorf("ascii render of a finished run should read \"x Done\":\n%s", view)
+	}
+}
+
+func TestCompletedStepShowsDurationInVerboseMode(t *testing.T) {
+	r := newTestRenderer()
+	r.SetShowStepDurations(true)
+	r.CompleteStep(0, 3*time.Second)
+
+	view := StripANSI(r.Render(89))
+	done := lineWith(view, testStepNames[0])
+	if !strings.HasSuffix(done, " 3.0s") {
+		t.Errorf("completed step line = %q, want it to end with its 3.0s duration", done)
+	}
+	pending := lineWith(view, testStepNames[1])
+	if styles.VisibleWidth(pending) != styles.VisibleWidth(done) {
+		t.Errorf("pending line width %d != completed line width %d, bars misaligned", styles.VisibleWidth(pending), styles.VisibleWidth(done))
+	}
+
+	r.SetShowStepDurations(false)
+	if line := lineWith(StripANSI(r.Render(89)), testStepNames[0]); strings.Contains(line, "3.0s") {
+		t.Errorf("step line without durations = %q, want no duration", line)
+	}
+}
+
+func TestFormatStepDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0.0s"},
+		{3 * time.Second, "3.0s"},
+		{1500 * time.Millisecond, "1.5s"},
+		{65 * time.Second, "1m 05s"},
+	}
+	for _, tt := range tests {
+		if got := formatStepDuration(tt.d); got != tt.want {
+			t.Errorf("formatStepDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestWideAndTruncatedStepNamesStayAligned(t *testing.T) {
+	names := []string{
+		"🚀 Launching",
+		"Configuración de dependencias",
+		"初始化项目并安装依赖项和配置开发环境以及生成文档",
+		"Installing dependencies",
+	}
+	r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", names, true, false)
+	r.Resize(70)
+
+	view := r.Render(70)
+	if !utf8.ValidString(view) {
+		t.Fatal("render cut a multibyte rune in half")
+	}
+
+	want := styles.VisibleWidth(lineWith(view, "Installing dependencies"))
+	for _, prefix := range []string{"🚀 Launching", "Configuración", "初始化项目"} {
+		line := lineWith(view, prefix)
+		if got := styles.VisibleWidth(line); got != want || got > 70 {
+			t.Errorf("step line %q is %d columns wide, want %d within 70", line, got, want)
+		}
+	}
+	if line := lineWith(view, "初始化项目"); !strings.Contains(line, "...") {
+		t.Errorf("long CJK step line %q was not ellipsized", line)
+	}
+}
+
+func TestSkippedComponentsUseThemeSkippedColor(t *testing.T) {
+	theme := styles.DefaultTheme()
+	theme.Skipped = "\x1b[36m"
+
+	r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", testStepNames, true, true)
+	r.SetTheme(theme)
+	name := r.coreTechnologies[0].Name
+	setComponentStatuses(r, map[string]string{name: "skipped"})
+
+	view := r.Render(89)
+	line := lineWith(view[strings.Index(view, "APPLICATION COMPONENTS"):], name)
+	if !strings.Contains(line, theme.Skipped+theme.Italic+"[skipped]") {
+		t.Errorf("skipped status is not drawn in Theme.Skipped: %q", line)
+	}
+	if !strings.Contains(line, theme.Skipped+theme.Italic+name) {
+		t.Errorf("skipped component name is not drawn in Theme.Skipped: %q", line)
+	}
+}
+
+// failingWriter rejects every write
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRenderToWritesTheRenderedFrame(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := newTestRenderer()
+	r.SetClock(func() time.Time { return now })
+	r.UpdateStep(0, 0.5, "Scaffolding", nil)
+
+	var buf bytes.Buffer
+	n, err := r.RenderTo(&buf, 89)
+	if err != nil {
+		t.Fatalf("RenderTo() error = %v", err)
+	}
+	if n != buf.Len() {
+		t.Errorf("RenderTo() reported %d bytes, wrote %d", n, buf.Len())
+	}
+	if want := r.Render(89); buf.String() != want {
+		t.Errorf("RenderTo() wrote\n%s\nwant Render()'s frame\n%s", buf.String(), want)
+	}
+
+	if _, err := r.RenderTo(failingWriter{}, 89); err == nil {
+		t.Error("RenderTo() swallowed the writer's error")
+	}
+}
+
+func TestVerbosityLevelsShowSections(t *testing.T) {
+	tests := []struct {
+		level          config.VerbosityLevel
+		wantComponents bool
+		wantTimings    bool
+	}{
+		{config.VerbosityQuiet, false, false},
+		{config.VerbosityConcise, false, false},
+		{config.VerbosityDefault, true, true},
+		{config.VerbosityVerbose, true, true},
+		{config.VerbosityDebug, true, true},
+	}
+
+	for _, tt := range tests {
+		r := newTestRenderer()
+		r.SetVerbosity(config.NewVerbosityConfig(tt.level))
+		view := StripANSI(r.Render(89))
+
+		if got := strings.Contains(view, "APPLICATION COMPONENTS"); got != tt.wantComponents {
+			t.Errorf("%s: components section shown = %t, want %t", tt.level, got, tt.wantComponents)
+		}
+		if got := strings.Contains(view, "Elapsed Time:"); got != tt.wantTimings {
+			t.Errorf("%s: footer timings shown = %t, want %t", tt.level, got, tt.wantTimings)
+		}
+
+		// The step table and directory line stay, and the frame still ends on a separator
+		for _, want := range []string{testStepNames[0], "Target Directory:"} {
+			if !strings.Contains(view, want) {
+				t.Errorf("%s: frame is missing %q", tt.level, want)
+			}
+		}
+		lines := strings.Split(view, "\n")
+		if last := lines[len(lines)-1]; last != strings.Repeat("-", 89) {
+			t.Errorf("%s: frame ends with %q, want a separator", tt.level, last)
+		}
+	}
+}
+
+func TestRendererFromConfigOmitsDeselectedComponents(t *testing.T) {
+	devOnly := &config.UserConfiguration{
+		Template: config.TemplateConfig{Type: config.JavaScript},
+		Testing:  config.TestingConfig{UnitTesting: true},
+	}
+	full := &config.UserConfiguration{
+		Template:        config.TemplateConfig{Type: config.TypeScript},
+		ProductionSetup: config.ProductionConfig{Docker: true, TrustBridge: true, GRPC: true, GridHDFS: true},
+		Testing:         config.TestingConfig{UnitTesting: true, E2ETesting: true, Coverage: true},
+	}
+	production := []string{"TrustBridge SSO", "gRPC Web", "GRID/HDFS Access"}
+
+	r := NewEnhancedRendererFromConfig("TestApp", "./TestApp", devOnly, testStepNames)
+	statuses := r.ComponentStatuses()
+	for _, name := range append(production, "TypeScript", "Playwright (E2E)") {
+		if _, ok := statuses[name]; ok {
+			t.Errorf("dev-only JavaScript config still lists %q", name)
+		}
+	}
+	for _, name := range []string{"React", "Vitest", "CREWS API"} {
+		if _, ok := statuses[name]; !ok {
+			t.Errorf("dev-only config dropped always-installed %q", name)
+		}
+	}
+	if header := StripANSI(r.Render(89)); !strings.Contains(header, "DEV SETUP") {
+		t.Errorf("dev-only config is not rendered as a dev setup:\n%s", header)
+	}
+
+	statuses = NewEnhancedRendererFromConfig("TestApp", "./TestApp", full, testStepNames).ComponentStatuses()
+	for _, name := range append(production, "TypeScript", "Playwright (E2E)") {
+		if status, ok := statuses[name]; !ok || status != "queued" {
+			t.Errorf("full config lists %q as %q (present %t), want queued", name, status, ok)
+		}
+	}
+}
+
+func TestRenderPlainMatchesStrippedRender(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	states := map[string]func(r *EnhancedRenderer){
+		"fresh": func(r *EnhancedRenderer) {},
+		"running": func(r *EnhancedRenderer) {
+			r.SetShowSubSteps(true)
+			r.CompleteStep(0, 2*time.Second)
+			r.UpdateStep(1, 0.4, "Fetching packages", []string{"react", "vite"})
+		},
+		"failed and skipped": func(r *EnhancedRenderer) {
+			r.FailStep(0)
+			setComponentStatuses(r, map[string]string{r.coreTechnologies[0].Name: "skipped", r.coreTechnologies[1].Name: "failed"})
+		},
+		"done": func(r *EnhancedRenderer) {
+			for i := range testStepNames {
+				r.CompleteStep(i, time.Second)
+			}
+		},
+	}
+
+	for name, setup := range states {
+		for _, width := range []int{60, 89, 120} {
+			r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", testStepNames, true, true)
+			r.SetClock(func() time.Time { return now })
+			setup(r)
+
+			colored := r.Render(width)
+			if !strings.Contains(colored, "\x1b[") {
+				t.Fatalf("%s at %d: colored render has no ANSI codes", name, width)
+			}
+			if plain := r.RenderPlain(width); StripANSI(colored) != plain {
+				t.Errorf("%s at %d: RenderPlain() differs from StripANSI(Render()):\n%s\n---\n%s", name, width, plain, StripANSI(colored))
+			}
+			if again := r.Render(width); again != colored {
+				t.Errorf("%s at %d: RenderPlain() did not restore the theme", name, width)
+			}
+		}
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"plain", "plain"},
+		{"\x1b[92m[✓]\x1b[0m done", "[✓] done"},
+		{"\x1b[1;38;5;208mbold orange\x1b[22m\x1b[0m", "bold orange"},
+		{"\x1b[3m\x1b[90mitalic grey\x1b[0m", "italic grey"},
+	}
+	for _, tt := range tests {
+		if got := StripANSI(tt.input); got != tt.want {
+			t.Errorf("StripANSI(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewEnhancedRendererWithColorGatesEscapes(t *testing.T) {
+	for _, enableColor := range []bool{true, false} {
+		r := NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", testStepNames, true, enableColor)
+		r.CompleteStep(0, time.Second)
+
+		view := r.Render(80)
+		if hasANSI := strings.Contains(view, "\x1b["); hasANSI != enableColor {
+			t.Errorf("enableColor=%t: render contains ANSI escapes = %t, want %t", enableColor, hasANSI, enableColor)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	simerrors "github.com/bthompso/engx-ergonomics-poc/internal/simulation/errors"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SeverityStyle returns the title style for a severity: low is yellow, medium
+// orange, high and critical red. Colors drop out automatically in no-color mode.
+func SeverityStyle(s simerrors.Severity) lipgloss.Style {
+	switch s {
+	case simerrors.SeverityLow:
+		return lipgloss.NewStyle().Foreground(styles.Highlight)
+	case simerrors.SeverityMedium:
+		return lipgloss.NewStyle().Foreground(styles.Caution)
+	default:
+		return lipgloss.NewStyle().Foreground(styles.Error).Bold(true)
+	}
+}
+
+// FormatErrorMessage formats an error scenario into a user-friendly message
+func FormatErrorMessage(scenario *simerrors.ErrorScenario) string {
+	title := fmt.Sprintf("[%s] %s", strings.ToUpper(scenario.Severity.String()), scenario.Title)
+	msg := fmt.Sprintf("❌ Error: %s\n\n", SeverityStyle(scenario.Severity).Render(title))
+	msg += fmt.Sprintf("🔍 Problem: %s\n", scenario.Description)
+
+	if len(scenario.Causes) > 0 {
+		msg += "📋 Likely Causes:\n"
+		for _, cause := range scenario.Causes {
+			msg += fmt.Sprintf("   • %s\n", cause)
+		}
+		msg += "\n"
+	}
+
+	if len(scenario.Actions) > 0 {
+		msg += "🛠️  Suggested Actions:\n"
+		for i, action := range scenario.Actions {
+			msg += fmt.Sprintf("   %d. %s: %s\n", i+1, action.Description, action.Command)
+		}
+		msg += "\n"
+	}
+
+	if scenario.QuickFix != nil {
+		msg += fmt.Sprintf("⚡ Quick Fix Available:\n   Run '%s' to %s\n\n",
+			scenario.QuickFix.Command, scenario.QuickFix.Description)
+	}
+
+	if scenario.HelpCommand != "" {
+		msg += fmt.Sprintf("❓ More Help: %s\n", scenario.HelpCommand)
+	}
+
+	return msg
+}
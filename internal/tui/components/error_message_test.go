@@ -0,0 +1,50 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	simerrors "github.com/bthompso/engx-ergonomics-poc/internal/simulation/errors"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// withColorProfile forces a lipgloss color profile for the duration of a test
+func withColorProfile(t *testing.T, profile termenv.Profile) {
+	t.Helper()
+	previous := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(profile)
+	t.Cleanup(func() { lipgloss.SetColorProfile(previous) })
+}
+
+func TestFormatErrorMessageColorsBySeverity(t *testing.T) {
+	withColorProfile(t, termenv.TrueColor)
+	scenario := &simerrors.ErrorScenario{Title: "Disk Full", Description: "No space left", Severity: simerrors.SeverityCritical}
+
+	msg := FormatErrorMessage(scenario)
+
+	wantTitle := lipgloss.NewStyle().Foreground(styles.Error).Bold(true).Render("[CRITICAL] Disk Full")
+	if !strings.Contains(msg, wantTitle) {
+		t.Errorf("message does not contain the red critical title %q:\n%q", wantTitle, msg)
+	}
+	red := termenv.TrueColor.Color(string(styles.Error)).Sequence(false)
+	if !strings.Contains(msg, red) {
+		t.Errorf("message does not use the red foreground %q:\n%q", red, msg)
+	}
+}
+
+func TestFormatErrorMessagePlainWithoutColor(t *testing.T) {
+	withColorProfile(t, termenv.Ascii)
+
+	for _, severity := range []simerrors.Severity{simerrors.SeverityLow, simerrors.SeverityMedium, simerrors.SeverityHigh, simerrors.SeverityCritical} {
+		msg := FormatErrorMessage(&simerrors.ErrorScenario{Title: "Broken", Severity: severity})
+		want := "❌ Error: [" + strings.ToUpper(severity.String()) + "] Broken\n"
+		if !strings.HasPrefix(msg, want) {
+			t.Errorf("%s: message starts %q, want %q", severity, msg, want)
+		}
+		if strings.Contains(msg, "\x1b[") {
+			t.Errorf("%s: no-color message contains ANSI codes", severity)
+		}
+	}
+}
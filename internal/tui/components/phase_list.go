@@ -0,0 +1,87 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns the short name of the installation phase
+func (p ComponentInstallationPhase) String() string {
+	switch p {
+	case PhaseDependencies:
+		return "dependencies"
+	case PhaseProjectStructure:
+		return "project-structure"
+	case PhaseTestingFrameworks:
+		return "testing"
+	case PhaseDocumentation:
+		return "documentation"
+	case PhaseFinalizing:
+		return "finalizing"
+	default:
+		return "unknown"
+	}
+}
+
+// allInstallationPhases lists the installation phases in execution order
+var allInstallationPhases = []ComponentInstallationPhase{
+	PhaseDependencies,
+	PhaseProjectStructure,
+	PhaseTestingFrameworks,
+	PhaseDocumentation,
+	PhaseFinalizing,
+}
+
+// RenderPhaseList renders each installation phase with the steps mapped to it
+// and the components it installs, along with their progress windows
+func RenderPhaseList(stepNames []string, cm *ComponentManager) string {
+	var output strings.Builder
+
+	nameWidth := 0
+	for _, step := range cm.installationPlan {
+		for _, name := range step.ComponentNames {
+			if len(name) > nameWidth {
+				nameWidth = len(name)
+			}
+		}
+	}
+
+	for i, phase := range allInstallationPhases {
+		if i > 0 {
+			output.WriteString("\n")
+		}
+		output.WriteString(fmt.Sprintf("%s\n", phase))
+
+		var steps []string
+		for _, name := range stepNames {
+			if stepPhase, ok := LookupStepPhase(name); ok && stepPhase == phase {
+				steps = append(steps, name)
+			}
+		}
+		if len(steps) == 0 {
+			output.WriteString("  steps: (none in this plan)\n")
+		} else {
+			output.WriteString(fmt.Sprintf("  steps: %s\n", strings.Join(steps, ", ")))
+		}
+
+		components := 0
+		for _, step := range cm.installationPlan {
+			if step.Phase != phase {
+				continue
+			}
+			for _, name := range step.ComponentNames {
+				if components == 0 {
+					output.WriteString("  components:\n")
+				}
+				output.WriteString(fmt.Sprintf("    %-*s  %3.0f%% - %3.0f%%\n",
+					nameWidth, name, step.ProgressStart*100, step.ProgressEnd*100))
+				components++
+			}
+		}
+		if components == 0 {
+			output.WriteString("  components: (none)\n")
+		}
+	}
+
+	return output.String()
+}
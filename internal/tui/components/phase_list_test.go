@@ -0,0 +1,49 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+)
+
+func TestRenderPhaseListCoversEveryPhase(t *testing.T) {
+	var stepNames []string
+	for _, step := range progress.NewCreateTracker(false).GetSteps() {
+		stepNames = append(stepNames, step.Name)
+	}
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	output := RenderPhaseList(stepNames, cm)
+
+	// Split the output into one block per phase heading
+	blocks := make(map[string]string)
+	var order []string
+	for _, block := range strings.Split(output, "\n\n") {
+		heading, _, _ := strings.Cut(block, "\n")
+		blocks[heading] = block
+		order = append(order, heading)
+	}
+
+	want := []string{"dependencies", "project-structure", "testing", "documentation", "finalizing"}
+	if strings.Join(order, ",") != strings.Join(want, ",") {
+		t.Fatalf("phases = %q, want %q", order, want)
+	}
+
+	for _, phase := range allInstallationPhases {
+		block := blocks[phase.String()]
+		for _, name := range cm.GetComponentsForPhase(phase) {
+			if !strings.Contains(block, name) {
+				t.Errorf("%s phase does not list component %q:\n%s", phase, name, block)
+			}
+		}
+		for _, name := range stepNames {
+			if stepPhase, ok := LookupStepPhase(name); ok && stepPhase == phase && !strings.Contains(block, name) {
+				t.Errorf("%s phase does not list step %q:\n%s", phase, name, block)
+			}
+		}
+	}
+
+	if line := lineWith(blocks["testing"], "StoryBook"); !strings.Contains(line, " 90% - 100%") {
+		t.Errorf("StoryBook window = %q, want 90%% - 100%%", line)
+	}
+}
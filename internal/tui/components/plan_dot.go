@@ -0,0 +1,51 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderPlanDOT renders the step sequence and the components attached to each
+// step's installation phase as a Graphviz DOT graph
+func RenderPlanDOT(stepNames []string, cm *ComponentManager) string {
+	var output strings.Builder
+
+	output.WriteString("digraph engx_plan {\n")
+	output.WriteString("  rankdir=LR;\n")
+	output.WriteString("  node [shape=box, style=rounded];\n\n")
+
+	// Steps as a sequence
+	for i, name := range stepNames {
+		output.WriteString(fmt.Sprintf("  step%d [label=%s];\n", i, dotQuote(name)))
+	}
+	for i := 1; i < len(stepNames); i++ {
+		output.WriteString(fmt.Sprintf("  step%d -> step%d;\n", i-1, i))
+	}
+
+	// Components as leaves of the step that installs them
+	for i, name := range stepNames {
+		phase, ok := LookupStepPhase(name)
+		if !ok {
+			continue
+		}
+
+		components := cm.GetComponentsForPhase(phase)
+		if len(components) == 0 {
+			continue
+		}
+
+		output.WriteString("\n")
+		for j, component := range components {
+			output.WriteString(fmt.Sprintf("  step%d_c%d [label=%s, shape=note];\n", i, j, dotQuote(component)))
+			output.WriteString(fmt.Sprintf("  step%d -> step%d_c%d [style=dashed, arrowhead=none];\n", i, i, j))
+		}
+	}
+
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// dotQuote quotes a label for use in a DOT file
+func dotQuote(label string) string {
+	return `"` + strings.ReplaceAll(label, `"`, `\"`) + `"`
+}
@@ -0,0 +1,49 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRenderPlanDOTListsStepsInOrder(t *testing.T) {
+	cm := NewComponentManager(DefaultSuccessRateFactor)
+	dot := RenderPlanDOT(testStepNames, cm)
+
+	if !strings.HasPrefix(dot, "digraph engx_plan {") || !strings.HasSuffix(dot, "}\n") {
+		t.Fatalf("output is not a digraph:\n%s", dot)
+	}
+
+	// Step nodes and the edges between them appear in sequence
+	var want []string
+	for i, name := range testStepNames {
+		want = append(want, fmt.Sprintf("step%d [label=%q];", i, name))
+	}
+	for i := 1; i < len(testStepNames); i++ {
+		want = append(want, fmt.Sprintf("step%d -> step%d;", i-1, i))
+	}
+
+	pos := 0
+	for _, line := range want {
+		idx := strings.Index(dot[pos:], line)
+		if idx < 0 {
+			t.Fatalf("missing or out of order %q in:\n%s", line, dot)
+		}
+		pos += idx + len(line)
+	}
+
+	// Components hang off the step that installs them
+	phase, ok := LookupStepPhase(testStepNames[1])
+	if !ok {
+		t.Fatalf("LookupStepPhase(%q) not found", testStepNames[1])
+	}
+	for j, component := range cm.GetComponentsForPhase(phase) {
+		node := fmt.Sprintf("step1_c%d [label=%q, shape=note];", j, component)
+		if !strings.Contains(dot, node) {
+			t.Errorf("missing component node %q", node)
+		}
+		if edge := fmt.Sprintf("step1 -> step1_c%d", j); !strings.Contains(dot, edge) {
+			t.Errorf("missing component edge %q", edge)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package prompts
 
 import (
 	"fmt"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -16,6 +17,7 @@ type PromptComponent interface {
 	IsComplete() bool
 	GetTitle() string
 	GetHelp() string
+	Reset()
 }
 
 // PromptType defines the different types of prompts available
@@ -112,6 +114,12 @@ func (bp *BasePrompt) SetCompleted(completed bool) {
 	bp.completed = completed
 }
 
+// ResetState clears completion and error state so the prompt can be answered again
+func (bp *BasePrompt) ResetState() {
+	bp.completed = false
+	bp.error = nil
+}
+
 // SetError sets an error for the prompt
 func (bp *BasePrompt) SetError(err error) {
 	bp.error = err
@@ -132,6 +140,13 @@ func (bp *BasePrompt) IsShowingHelp() bool {
 	return bp.showHelp
 }
 
+// IsPastedInput reports whether a key message carries pasted text rather than a
+// single keystroke. Bubble Tea v0.24 has no bracketed paste, so a paste arrives
+// as one message holding a run of several runes.
+func IsPastedInput(msg tea.KeyMsg) bool {
+	return msg.Type == tea.KeyRunes && len(msg.Runes) > 1
+}
+
 // ValidateRequired checks if a required prompt has a value
 func ValidateRequired(required bool, value interface{}) error {
 	if !required {
@@ -51,6 +51,11 @@ func (cs *ConfigurationSummary) Init() tea.Cmd {
 func (cs *ConfigurationSummary) Update(msg tea.Msg) (PromptComponent, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Ignore pastes so they can't trigger a burst of toggles or shortcuts
+		if IsPastedInput(msg) {
+			return cs, nil
+		}
+
 		switch msg.String() {
 		case "up", "k":
 			if cs.selectedOption > 0 {
@@ -113,6 +118,14 @@ func (cs *ConfigurationSummary) View() string {
 
 	view.WriteString(summaryStyle.Render(cs.config.GetSummary()))
 
+	// Show what the navigation choice means for the page layout
+	previewStyle := lipgloss.NewStyle().
+		Foreground(styles.Muted).
+		MarginBottom(1)
+
+	view.WriteString(previewStyle.Render(renderNavigationPreview(cs.config.Navigation)))
+	view.WriteString("\n\n")
+
 	// Estimated setup time
 	estimatedTime := config.EstimateSetupTime(*cs.config)
 	timeStyle := lipgloss.NewStyle().
@@ -194,6 +207,13 @@ func (cs *ConfigurationSummary) Validate() error {
 	return nil
 }
 
+// Reset implements PromptComponent - returns to the default "Create Project" option
+func (cs *ConfigurationSummary) Reset() {
+	cs.confirmed = false
+	cs.selectedOption = 0
+	cs.ResetState()
+}
+
 // Helper methods
 
 func (cs *ConfigurationSummary) renderOptions() string {
@@ -223,6 +243,31 @@ func (cs *ConfigurationSummary) renderOptions() string {
 	return options.String()
 }
 
+// renderNavigationPreview draws the page layout implied by the navigation choice
+func renderNavigationPreview(nav config.NavigationConfig) string {
+	if nav.UseFederatedNav {
+		return strings.Join([]string{
+			"Navigation: Federated Global Nav & Chrome",
+			"┌────────────────────────────────────┐",
+			"│ ≡ Global Nav    Apps ▾   Help    ◉ │ ← shared company chrome",
+			"├────────────────────────────────────┤",
+			"│   Your app content                 │",
+			"│                                    │",
+			"└────────────────────────────────────┘",
+		}, "\n")
+	}
+
+	return strings.Join([]string{
+		"Navigation: Standalone App Header & Chrome",
+		"┌────────────────────────────────────┐",
+		"│ Your App         Home   Docs     ◉ │ ← header you own",
+		"├────────────────────────────────────┤",
+		"│   Your app content                 │",
+		"│                                    │",
+		"└────────────────────────────────────┘",
+	}, "\n")
+}
+
 func formatDuration(seconds int) string {
 	duration := time.Duration(seconds) * time.Second
 
@@ -0,0 +1,35 @@
+package prompts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+)
+
+func TestConfigurationSummaryPreviewsNavigation(t *testing.T) {
+	tests := []struct {
+		name      string
+		federated bool
+		want      string
+		notWant   string
+	}{
+		{name: "federated", federated: true, want: "shared company chrome", notWant: "header you own"},
+		{name: "standalone", federated: false, want: "header you own", notWant: "shared company chrome"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.GetSmartDefaults("TestApp")
+			cfg.Navigation.UseFederatedNav = tt.federated
+
+			view := NewConfigurationSummary(&cfg).View()
+			if !strings.Contains(view, tt.want) {
+				t.Errorf("View() is missing the %s diagram (%q):\n%s", tt.name, tt.want, view)
+			}
+			if strings.Contains(view, tt.notWant) {
+				t.Errorf("View() also renders the other layout (%q):\n%s", tt.notWant, view)
+			}
+		})
+	}
+}
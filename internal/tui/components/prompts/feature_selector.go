@@ -25,9 +25,9 @@ func (fc FeatureChoice) FilterValue() string {
 }
 
 func (fc FeatureChoice) Title() string {
-	checkbox := "[ ]"
+	checkbox := styles.CurrentGlyphs().Pending
 	if fc.selected {
-		checkbox = "[✓]"
+		checkbox = styles.CurrentGlyphs().Complete
 	}
 
 	title := fmt.Sprintf("%s %s", checkbox, fc.name)
@@ -48,6 +48,7 @@ type FeatureSelector struct {
 	choices     []FeatureChoice
 	category    string
 	minSelected int
+	maxSelected int // 0 = no cap; 1 = radio behavior
 }
 
 // NewDevFeatureSelector creates a development features selector
@@ -61,19 +62,23 @@ func NewDevFeatureSelector() *FeatureSelector {
 		{name: "Storybook", description: "Component development environment", selected: false},
 	}
 
-	return newFeatureSelector("Development Features", choices, 0)
+	return newFeatureSelector("Development Features", choices, 0, 0)
 }
 
 // NewProductionFeatureSelector creates a production features selector
 func NewProductionFeatureSelector() *FeatureSelector {
 	choices := []FeatureChoice{
 		{name: "Docker", description: "Containerization for consistent deployments", selected: false},
+		{name: "Azure", description: "Deploy to Azure App Service", selected: false},
 		{name: "CI/CD Pipeline", description: "Automated testing and deployment", selected: false},
 		{name: "Monitoring", description: "Error tracking and performance monitoring", selected: false},
 		{name: "Analytics", description: "User behavior and performance analytics", selected: false},
+		{name: "TrustBridge SSO", description: "Single sign-on for production data access", selected: false},
+		{name: "gRPC Web", description: "Call production gRPC services from the browser", selected: false},
+		{name: "GRID/HDFS Access", description: "Read production datasets from GRID/HDFS", selected: false},
 	}
 
-	return newFeatureSelector("Production Setup", choices, 0)
+	return newFeatureSelector("Production Setup", choices, 0, 0)
 }
 
 // NewTestingFeatureSelector creates a testing features selector
@@ -84,7 +89,7 @@ func NewTestingFeatureSelector() *FeatureSelector {
 		{name: "Coverage Reports", description: "Code coverage tracking", selected: false},
 	}
 
-	return newFeatureSelector("Testing Setup", choices, 0)
+	return newFeatureSelector("Testing Setup", choices, 0, 0)
 }
 
 // NewNavigationSelector creates a navigation configuration selector
@@ -94,11 +99,11 @@ func NewNavigationSelector() *FeatureSelector {
 		{name: "Standalone App Header & Chrome", description: "Use standalone app header and chrome templates", selected: true, recommended: true},
 	}
 
-	return newFeatureSelector("Navigation Configuration", choices, 1) // Require exactly one selection
+	return newFeatureSelector("Navigation Configuration", choices, 1, 1) // Require exactly one selection
 }
 
 // newFeatureSelector creates a new feature selector with the given configuration
-func newFeatureSelector(category string, choices []FeatureChoice, minSelected int) *FeatureSelector {
+func newFeatureSelector(category string, choices []FeatureChoice, minSelected, maxSelected int) *FeatureSelector {
 	// Convert to list items
 	items := make([]list.Item, len(choices))
 	for i, choice := range choices {
@@ -122,6 +127,7 @@ func newFeatureSelector(category string, choices []FeatureChoice, minSelected in
 		choices:     choices,
 		category:    category,
 		minSelected: minSelected,
+		maxSelected: maxSelected,
 	}
 }
 
@@ -134,13 +140,16 @@ func (fs *FeatureSelector) Init() tea.Cmd {
 func (fs *FeatureSelector) Update(msg tea.Msg) (PromptComponent, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Ignore pastes so they can't trigger a burst of toggles or shortcuts
+		if IsPastedInput(msg) {
+			return fs, nil
+		}
+
 		switch msg.String() {
 		case " ", "spacebar":
 			// Toggle selection
 			if fs.list.Index() >= 0 && fs.list.Index() < len(fs.choices) {
-				fs.choices[fs.list.Index()].selected = !fs.choices[fs.list.Index()].selected
-				// Update the list item
-				fs.list.SetItem(fs.list.Index(), fs.choices[fs.list.Index()])
+				fs.toggle(fs.list.Index())
 			}
 			return fs, nil
 		case "enter":
@@ -153,7 +162,11 @@ func (fs *FeatureSelector) Update(msg tea.Msg) (PromptComponent, tea.Cmd) {
 				return CompletePromptMsg{}
 			})
 		case "a":
-			// Select all
+			// Select all, unless that would exceed the cap
+			if fs.maxSelected > 0 && len(fs.choices) > fs.maxSelected {
+				fs.SetError(fs.maxSelectedError())
+				return fs, nil
+			}
 			for i := range fs.choices {
 				fs.choices[i].selected = true
 				fs.list.SetItem(i, fs.choices[i])
@@ -247,7 +260,7 @@ func (fs *FeatureSelector) View() string {
 		Foreground(styles.Muted).
 		MarginTop(1)
 
-	footer := "[Space] Toggle • [Enter] Continue • [↑↓] Navigate • [a] All • [n] None • [h] Help"
+	footer := "[Space] Toggle • [Enter] Continue • [↑↓] Navigate • [a] All • [n] None • [r] Reset • [h] Help"
 	view.WriteString(footerStyle.Render(footer))
 
 	return view.String()
@@ -267,10 +280,14 @@ func (fs *FeatureSelector) GetValue() interface{} {
 		}
 	case "Production Setup":
 		return config.ProductionConfig{
-			Docker:     fs.isSelected("Docker"),
-			CI_CD:      fs.isSelected("CI/CD Pipeline"),
-			Monitoring: fs.isSelected("Monitoring"),
-			Analytics:  fs.isSelected("Analytics"),
+			Docker:      fs.isSelected("Docker"),
+			Azure:       fs.isSelected("Azure"),
+			CI_CD:       fs.isSelected("CI/CD Pipeline"),
+			Monitoring:  fs.isSelected("Monitoring"),
+			Analytics:   fs.isSelected("Analytics"),
+			TrustBridge: fs.isSelected("TrustBridge SSO"),
+			GRPC:        fs.isSelected("gRPC Web"),
+			GridHDFS:    fs.isSelected("GRID/HDFS Access"),
 		}
 	case "Testing Setup":
 		return config.TestingConfig{
@@ -297,9 +314,13 @@ func (fs *FeatureSelector) SetValue(value interface{}) {
 		fs.setSelected("React DevTools", v.DevTools)
 	case config.ProductionConfig:
 		fs.setSelected("Docker", v.Docker)
+		fs.setSelected("Azure", v.Azure)
 		fs.setSelected("CI/CD Pipeline", v.CI_CD)
 		fs.setSelected("Monitoring", v.Monitoring)
 		fs.setSelected("Analytics", v.Analytics)
+		fs.setSelected("TrustBridge SSO", v.TrustBridge)
+		fs.setSelected("gRPC Web", v.GRPC)
+		fs.setSelected("GRID/HDFS Access", v.GridHDFS)
 	case config.TestingConfig:
 		fs.setSelected("Unit Testing", v.UnitTesting)
 		fs.setSelected("E2E Testing", v.E2ETesting)
@@ -314,11 +335,97 @@ func (fs *FeatureSelector) SetValue(value interface{}) {
 func (fs *FeatureSelector) Validate() error {
 	selectedCount := fs.getSelectedCount()
 	if selectedCount < fs.minSelected {
-		return fmt.Errorf("please select at least %d feature(s)", fs.minSelected)
+		if fs.minSelected == 1 {
+			return fmt.Errorf("please select at least 1 option")
+		}
+		return fmt.Errorf("please select at least %d options", fs.minSelected)
+	}
+	if fs.maxSelected > 0 && selectedCount > fs.maxSelected {
+		return fs.maxSelectedError()
 	}
 	return nil
 }
 
+// maxSelectedError explains the selection cap
+func (fs *FeatureSelector) maxSelectedError() error {
+	if fs.maxSelected == 1 {
+		return fmt.Errorf("please select at most 1 option")
+	}
+	return fmt.Errorf("please select at most %d options", fs.maxSelected)
+}
+
+// toggle flips the choice at index; in a max-1 group selecting a choice
+// deselects the others (radio behavior), and larger caps block the toggle
+func (fs *FeatureSelector) toggle(index int) {
+	if fs.choices[index].selected {
+		fs.choices[index].selected = false
+		fs.list.SetItem(index, fs.choices[index])
+		fs.SetError(nil)
+		return
+	}
+
+	if fs.maxSelected == 1 {
+		for i := range fs.choices {
+			if i != index && fs.choices[i].selected {
+				fs.choices[i].selected = false
+				fs.list.SetItem(i, fs.choices[i])
+			}
+		}
+	} else if fs.maxSelected > 0 && fs.getSelectedCount() >= fs.maxSelected {
+		fs.SetError(fs.maxSelectedError())
+		return
+	}
+
+	fs.choices[index].selected = true
+	fs.list.SetItem(index, fs.choices[index])
+	fs.SetError(nil)
+}
+
+// SetMaxSelected caps how many choices can be selected (0 = no cap)
+func (fs *FeatureSelector) SetMaxSelected(n int) error {
+	if n < 0 {
+		return fmt.Errorf("max selected for %s must be >= 0, got %d", fs.category, n)
+	}
+	if n > 0 && n < fs.minSelected {
+		return fmt.Errorf("max selected for %s must be >= min selected (%d), got %d", fs.category, fs.minSelected, n)
+	}
+	fs.maxSelected = n
+	return nil
+}
+
+// MaxSelected returns the selection cap (0 = no cap)
+func (fs *FeatureSelector) MaxSelected() int {
+	return fs.maxSelected
+}
+
+// SetMinSelected sets how many choices must be selected before Enter is
+// accepted; any minimum above zero also makes the prompt required
+func (fs *FeatureSelector) SetMinSelected(n int) error {
+	if n < 0 || n > len(fs.choices) {
+		return fmt.Errorf("min selected for %s must be between 0 and %d, got %d", fs.category, len(fs.choices), n)
+	}
+	if fs.maxSelected > 0 && n > fs.maxSelected {
+		return fmt.Errorf("min selected for %s must be <= max selected (%d), got %d", fs.category, fs.maxSelected, n)
+	}
+	fs.minSelected = n
+	fs.required = n > 0
+	return nil
+}
+
+// MinSelected returns how many choices must be selected
+func (fs *FeatureSelector) MinSelected() int {
+	return fs.minSelected
+}
+
+// Reset implements PromptComponent - restores the recommended selections
+func (fs *FeatureSelector) Reset() {
+	for i := range fs.choices {
+		fs.choices[i].selected = fs.choices[i].recommended
+		fs.list.SetItem(i, fs.choices[i])
+	}
+	fs.ResetState()
+}
+
 // Helper methods
 func (fs *FeatureSelector) isSelected(name string) bool {
 	for _, choice := range fs.choices {
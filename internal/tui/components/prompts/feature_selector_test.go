@@ -0,0 +1,186 @@
+package prompts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestFeatureSelectorResetRestoresRecommended(t *testing.T) {
+	fs := NewDevFeatureSelector()
+	recommended := fs.GetValue().(config.DevFeatureConfig)
+
+	fs.SetValue(config.DevFeatureConfig{Husky: true, VSCodeConfig: true})
+	if fs.GetValue().(config.DevFeatureConfig) == recommended {
+		t.Fatal("SetValue() did not change the selections")
+	}
+
+	fs.Reset()
+
+	if got := fs.GetValue().(config.DevFeatureConfig); got != recommended {
+		t.Errorf("GetValue() after Reset() = %+v, want recommended %+v", got, recommended)
+	}
+	for i, choice := range fs.choices {
+		if choice.selected != choice.recommended {
+			t.Errorf("choice %q selected = %t, want %t", choice.name, choice.selected, choice.recommended)
+		}
+		if item := fs.list.Items()[i].(FeatureChoice); item.selected != choice.selected {
+			t.Errorf("list item %q not refreshed after Reset()", choice.name)
+		}
+	}
+}
+
+func TestFeatureSelectorIgnoresPastedRunes(t *testing.T) {
+	fs := NewDevFeatureSelector()
+	before := fs.GetValue().(config.DevFeatureConfig)
+
+	// A paste of spaces and shortcut letters arrives as one multi-rune message
+	paste := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("  r a ")}
+	fs.Update(paste)
+
+	if got := fs.GetValue().(config.DevFeatureConfig); got != before {
+		t.Errorf("GetValue() after paste = %+v, want unchanged %+v", got, before)
+	}
+	if fs.IsComplete() {
+		t.Error("paste completed the prompt")
+	}
+
+	// A single space keystroke still toggles
+	fs.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if got := fs.GetValue().(config.DevFeatureConfig); got == before {
+		t.Error("single space keystroke did not toggle the selection")
+	}
+}
+
+func TestIsPastedInput(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tea.KeyMsg
+		want bool
+	}{
+		{"single rune", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}, false},
+		{"rune run", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("abc")}, true},
+		{"space key", tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, false},
+		{"enter", tea.KeyMsg{Type: tea.KeyEnter}, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsPastedInput(tt.msg); got != tt.want {
+			t.Errorf("%s: IsPastedInput() = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFeatureChoiceTitleUsesASCIIGlyphs(t *testing.T) {
+	styles.ApplyGlyphMode(styles.GlyphASCII)
+	t.Cleanup(func() { styles.ApplyGlyphMode(styles.GlyphUnicode) })
+
+	for _, item := range NewDevFeatureSelector().list.Items() {
+		choice := item.(FeatureChoice)
+		want := "[ ] "
+		if choice.selected {
+			want = "[x] "
+		}
+		if title := choice.Title(); !strings.HasPrefix(title, want) {
+			t.Errorf("Title() = %q, want prefix %q", title, want)
+		}
+	}
+}
+
+func TestDevSelectorMinimumBlocksEmptySelection(t *testing.T) {
+	fs := NewDevFeatureSelector()
+	if err := fs.SetMinSelected(1); err != nil {
+		t.Fatalf("SetMinSelected(1) error = %v", err)
+	}
+	fs.SetValue(config.DevFeatureConfig{})
+
+	component, cmd := fs.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil || component.IsComplete() {
+		t.Fatal("Enter completed the prompt with nothing selected")
+	}
+	if err := fs.GetError(); err == nil || err.Error() != "please select at least 1 option" {
+		t.Errorf("validation error = %v, want \"please select at least 1 option\"", err)
+	}
+
+	fs.SetValue(config.DevFeatureConfig{HotReload: true})
+	if _, cmd := fs.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd == nil || !fs.IsComplete() {
+		t.Error("Enter did not complete the prompt once one feature was selected")
+	}
+}
+
+func TestSetMinSelected(t *testing.T) {
+	fs := NewDevFeatureSelector()
+
+	if err := fs.SetMinSelected(2); err != nil || fs.MinSelected() != 2 || !fs.required {
+		t.Errorf("SetMinSelected(2) = %v, min %d, required %t; want 2 and required", err, fs.MinSelected(), fs.required)
+	}
+	fs.SetValue(config.DevFeatureConfig{HotReload: true})
+	if err := fs.Validate(); err == nil || err.Error() != "please select at least 2 options" {
+		t.Errorf("Validate() with one of two = %v, want \"please select at least 2 options\"", err)
+	}
+
+	if err := fs.SetMinSelected(0); err != nil || fs.required {
+		t.Errorf("SetMinSelected(0) = %v, required %t; want an optional prompt", err, fs.required)
+	}
+	for _, n := range []int{-1, len(fs.choices) + 1} {
+		if err := fs.SetMinSelected(n); err == nil {
+			t.Errorf("SetMinSelected(%d) accepted an impossible minimum", n)
+		}
+	}
+}
+
+func TestNavigationSelectorIsRadio(t *testing.T) {
+	fs := NewNavigationSelector()
+	if fs.list.Index() != 0 || fs.choices[0].selected || !fs.choices[1].selected {
+		t.Fatalf("navigation starts at %d with selections %t/%t, want the cursor on the unselected first option",
+			fs.list.Index(), fs.choices[0].selected, fs.choices[1].selected)
+	}
+
+	fs.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	if !fs.choices[0].selected || fs.choices[1].selected {
+		t.Errorf("selections after choosing the first option = %t/%t, want the second deselected",
+			fs.choices[0].selected, fs.choices[1].selected)
+	}
+	if got := fs.GetValue().(config.NavigationConfig); !got.UseFederatedNav {
+		t.Errorf("GetValue() = %+v, want federated navigation", got)
+	}
+	if item := fs.list.Items()[1].(FeatureChoice); item.selected {
+		t.Error("list item for the deselected option was not refreshed")
+	}
+
+	fs.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if fs.getSelectedCount() != 1 || fs.GetError() == nil {
+		t.Errorf("select-all in a radio group left %d selected (error %v), want it blocked", fs.getSelectedCount(), fs.GetError())
+	}
+}
+
+func TestMaxSelectedBlocksExtraSelection(t *testing.T) {
+	fs := NewDevFeatureSelector()
+	fs.SetValue(config.DevFeatureConfig{HotReload: true, Husky: true})
+	if err := fs.SetMaxSelected(2); err != nil {
+		t.Fatalf("SetMaxSelected(2) error = %v", err)
+	}
+
+	third := -1
+	for i, choice := range fs.choices {
+		if !choice.selected {
+			third = i
+			break
+		}
+	}
+	fs.toggle(third)
+	if fs.choices[third].selected || fs.getSelectedCount() != 2 {
+		t.Errorf("a third selection went through a cap of 2 (%d selected)", fs.getSelectedCount())
+	}
+	if err := fs.GetError(); err == nil || err.Error() != "please select at most 2 options" {
+		t.Errorf("error = %v, want \"please select at most 2 options\"", err)
+	}
+
+	if err := fs.SetMaxSelected(-1); err == nil {
+		t.Error("SetMaxSelected(-1) accepted a negative cap")
+	}
+}
@@ -86,6 +86,11 @@ func (ts *TemplateSelector) Init() tea.Cmd {
 func (ts *TemplateSelector) Update(msg tea.Msg) (PromptComponent, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Ignore pastes so they can't trigger a burst of toggles or shortcuts
+		if IsPastedInput(msg) {
+			return ts, nil
+		}
+
 		switch msg.String() {
 		case "enter":
 			ts.selected = ts.list.Index()
@@ -146,7 +151,7 @@ func (ts *TemplateSelector) View() string {
 		Foreground(styles.Muted).
 		MarginTop(1)
 
-	footer := "[Enter] Select • [↑↓] Navigate • [h] Help • [q] Quit"
+	footer := "[Enter] Select • [↑↓] Navigate • [r] Reset • [h] Help • [q] Quit"
 	view.WriteString("\n")
 	view.WriteString(footerStyle.Render(footer))
 
@@ -179,6 +184,13 @@ func (ts *TemplateSelector) Validate() error {
 	return ValidateRequired(ts.required, ts.GetValue())
 }
 
+// Reset implements PromptComponent - restores the recommended TypeScript template
+func (ts *TemplateSelector) Reset() {
+	ts.selected = 0
+	ts.list.Select(0)
+	ts.ResetState()
+}
+
 // NewTemplateDelegate creates a custom list delegate for template items
 func NewTemplateDelegate() list.DefaultDelegate {
 	d := list.NewDefaultDelegate()
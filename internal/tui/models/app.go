@@ -1,6 +1,8 @@
 package models
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -16,6 +18,9 @@ import (
 	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
 )
 
+// DefaultMinTerminalWidth is the narrowest terminal the enhanced layout supports
+const DefaultMinTerminalWidth = 60
+
 // AppState represents the current state of the application
 type AppState int
 
@@ -49,6 +54,7 @@ type AppModel struct {
 	tracker    *progresssim.Tracker
 	chaosTracker *chaos.ChaosAwareTracker
 	startTime  time.Time
+	stepStartedAt time.Time // When the running step started, for per-step durations
 
 	// Execution state
 	currentStep   int
@@ -57,9 +63,24 @@ type AppModel struct {
 	logs          []string
 	error         error
 
-	// Window dimensions
-	width  int
-	height int
+	// Window dimensions, seeded from the terminal before the first WindowSizeMsg
+	width    int
+	height   int
+	minWidth int // Below this the narrow fallback view is used (0 = default)
+
+	// Fixed layout width for reproducible output (0 = follow the terminal)
+	forcedWidth int
+
+	// SIGWINCH delivery for the resize fallback (nil until Init); resizeDone
+	// releases a pending watch once StopResizeWatch turns the fallback off
+	resizeSignals chan os.Signal
+	resizeDone    chan struct{}
+	resizeStopped bool
+
+	// Latest reported size, applied once resizing goes quiet
+	pendingWidth  int
+	pendingHeight int
+	resizeSeq     int
 
 	// Completion state
 	completed bool
@@ -68,9 +89,57 @@ type AppModel struct {
 	aarGenerator *aar.AARGenerator
 	showAAR      bool
 	aarOutput    string
+	aarSummary   *aar.AARSummary
 
 	// Verbosity configuration
 	verbosityConfig *config.VerbosityConfig
+
+	// Color output
+	colorDisabled bool
+
+	// Separator rune for progress table and AAR dash runs (0 = default)
+	separator rune
+
+	// Palette for the progress table and AAR (nil = styles.DefaultTheme)
+	theme *styles.Theme
+
+	// Scrolling window size for the step list (0 = show every step)
+	maxStepsDisplay int
+
+	// Render the AAR as a few-line summary
+	compactAAR bool
+
+	// Whether the AAR prints after the run (--aar)
+	aarDisplay aar.DisplayMode
+
+	// Stack traces of chaos-failed steps are appended here when set
+	chaosTraceFile string
+
+	// Failure policy: keep going past failed steps instead of aborting
+	keepGoing   bool
+	failedSteps int
+
+	// Global multiplier for component success rates
+	componentSuccessRate    float64
+	componentSuccessRateSet bool
+	componentWindows        map[string]components.ComponentWindow
+
+	// Seed for the base tracker's natural step failures (0 = random)
+	seed int64
+
+	// Post-completion command browsing (opt-in)
+	browseCommands bool
+	commandPicker  *CommandPicker
+
+	// Post-completion menu (--interactive-complete)
+	interactiveComplete bool
+	completionMenu      *CompletionMenu
+
+	// Chaos recovery sub-state (learner-driven assistance)
+	chaosFailed       bool
+	recoveryStepIndex int
+	recoveryHint      string
+	recoverySolution  string
 }
 
 // getTemplateFromFlags extracts template from flags or returns default
@@ -139,7 +208,11 @@ func NewAppModel(command, target string, flags []string) *AppModel {
 	// For now, use nil config - will be updated when user configuration is available
 	aarGen := aar.NewAARGenerator(tracker, nil, startTime, projectPath)
 
+	width, height := detectTerminalSize()
+
 	return &AppModel{
+		width:              width,
+		height:             height,
 		state:              StateIdle,
 		command:            command,
 		target:             target,
@@ -204,6 +277,11 @@ func NewAppModelWithConfig(command, target string, flags []string, userConfig *c
 	targetDir := fmt.Sprintf("./%s", target)
 	template := userConfig.Template.Type.String()
 	renderer := components.NewEnhancedRenderer(appName, targetDir, template, stepNames, devOnly)
+	renderer.SetTestingConfig(userConfig.Testing)
+	renderer.SetProductionConfig(userConfig.ProductionSetup)
+	if tracker != nil {
+		renderer.SetTracker(tracker)
+	}
 
 	// Initialize with empty logs - all info is shown in the template
 	initialLogs := []string{}
@@ -212,8 +290,14 @@ func NewAppModelWithConfig(command, target string, flags []string, userConfig *c
 	startTime := time.Now()
 	projectPath := fmt.Sprintf("./%s", target)
 	aarGen := aar.NewAARGenerator(tracker, userConfig, startTime, projectPath)
+	aarGen.SetEstimatedDuration(estimatedRunDuration(tracker))
+	aarGen.SetConfigWarnings(configurationWarnings(userConfig))
+
+	width, height := detectTerminalSize()
 
 	return &AppModel{
+		width:              width,
+		height:             height,
 		state:              StateIdle,
 		command:            command,
 		target:             target,
@@ -281,7 +365,14 @@ func NewAppModelWithVerbosity(command, target string, flags []string, userConfig
 	targetDir := fmt.Sprintf("./%s", target)
 	template := userConfig.Template.Type.String()
 	renderer := components.NewEnhancedRenderer(appName, targetDir, template, stepNames, devOnly)
-	// TODO: Update renderer to be verbosity-aware
+	renderer.SetTestingConfig(userConfig.Testing)
+	renderer.SetProductionConfig(userConfig.ProductionSetup)
+	if tracker != nil {
+		renderer.SetTracker(tracker)
+	}
+	renderer.SetShowSubSteps(verbosityConfig.ShouldShow("substeps"))
+	renderer.SetShowStepDurations(verbosityConfig.ShouldShowDetailLevel(4))
+	renderer.SetVerbosity(verbosityConfig)
 
 	// Initialize with empty logs - all info is shown in the template
 	initialLogs := []string{}
@@ -290,12 +381,18 @@ func NewAppModelWithVerbosity(command, target string, flags []string, userConfig
 	startTime := time.Now()
 	projectPath := fmt.Sprintf("./%s", target)
 	aarGen := aar.NewAARGenerator(tracker, userConfig, startTime, projectPath)
+	aarGen.SetEstimatedDuration(estimatedRunDuration(tracker))
+	aarGen.SetConfigWarnings(configurationWarnings(userConfig))
 
 	// Debug output for verbosity configuration
 	verbosityConfig.DebugPrint("AppModel initialized with verbosity level: %s", verbosityConfig.Level.String())
 	verbosityConfig.DebugPrint("Tracker total steps: %d", tracker.TotalSteps())
 
+	width, height := detectTerminalSize()
+
 	return &AppModel{
+		width:              width,
+		height:             height,
 		state:              StateIdle,
 		command:            command,
 		target:             target,
@@ -336,6 +433,7 @@ func (m *AppModel) Init() tea.Cmd {
 			m.spinner.Tick,
 			m.startExecution(),
 			m.progressTicker(),
+			m.watchTerminalResize(),
 		)
 	} else {
 		// Start with prompting
@@ -343,6 +441,7 @@ func (m *AppModel) Init() tea.Cmd {
 		return tea.Batch(
 			m.spinner.Tick,
 			m.promptOrchestrator.Init(),
+			m.watchTerminalResize(),
 		)
 	}
 }
@@ -353,8 +452,22 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+		// Bubble Tea reports resizes itself, so the SIGWINCH fallback would
+		// only deliver each one twice
+		m.StopResizeWatch()
+		return m, m.queueResize(msg.Width, msg.Height)
+
+	case terminalResizeMsg:
+		if m.resizeStopped {
+			return m, nil
+		}
+		if m.resizeSeq > 0 && msg.width == m.pendingWidth && msg.height == m.pendingHeight {
+			return m, m.watchTerminalResize()
+		}
+		return m, tea.Batch(m.queueResize(msg.width, msg.height), m.watchTerminalResize())
+
+	case resizeSettledMsg:
+		m.applyResize(msg.seq)
 		return m, nil
 
 	case tea.KeyMsg:
@@ -380,6 +493,27 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			return m, cmd
+
+		case StateError:
+			if m.chaosFailed {
+				m.handleRecoveryKey(msg.String())
+			}
+			return m, nil
+
+		case StateComplete:
+			if m.completionMenu != nil {
+				return m, m.handleCompletionMenuKey(msg.String())
+			}
+			if m.commandPicker != nil {
+				return m, m.handleCommandPickerKey(msg.String())
+			}
+
+		case StateExecuting:
+			// Stretch the running step while narrating a demo
+			if msg.String() == "+" {
+				m.lingerOnCurrentStep()
+			}
+			return m, nil
 		}
 
 	// Handle prompting messages (like CompletePromptMsg)
@@ -404,18 +538,23 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.stepName = msg.StepName
 		// Skip adding logs - all information is shown in the template
 
-		// Record step completion to AAR generator
+		// Time the step that just finished and start timing the next one
+		stepDuration := time.Since(m.stepStartedAt)
+		if msg.Step > 0 {
+			m.stepStartedAt = time.Now()
+		}
+
+		// Record the previous step as completed; msg.StepName is the step
+		// that is starting now, so look the finished one up by index
 		if msg.Step > 0 && m.aarGenerator != nil {
-			// Record the previous step as completed
-			stepDuration := time.Since(m.startTime) // Simple duration calculation
-			if msg.Step <= m.totalSteps {
-				m.aarGenerator.RecordStep(m.stepName, aar.StepStatusSuccess, stepDuration, "")
+			if finished := m.tracker.GetStep(msg.Step - 1); finished != nil {
+				m.aarGenerator.RecordStep(finished.Name, aar.StepStatusSuccess, stepDuration, "")
 			}
 		}
 
 		// Mark previous step as complete if we advanced
 		if msg.Step > 0 && m.renderer != nil {
-			m.renderer.CompleteStep(msg.Step-1, time.Since(m.startTime))
+			m.renderer.CompleteStep(msg.Step-1, stepDuration)
 		}
 
 		// Set current step
@@ -428,7 +567,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.completed = true
 			// Mark final step as complete
 			if m.renderer != nil {
-				m.renderer.CompleteStep(msg.Step-1, time.Since(m.startTime))
+				m.renderer.CompleteStep(msg.Step-1, stepDuration)
 			}
 
 			// Generate AAR if enabled
@@ -455,8 +594,26 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.error = msg.Error
 		// Skip adding error logs - errors will be shown in footer
 
+	case StepFailedMsg:
+		// Keep-going: record the failure, mark the step and continue
+		m.failedSteps++
+		if m.aarGenerator != nil {
+			m.aarGenerator.MarkStepFailed(msg.StepName, msg.Error)
+		}
+		if m.renderer != nil {
+			m.renderer.FailStep(msg.StepIndex)
+		}
+		if m.tracker != nil {
+			m.tracker.FailStep(errors.New(msg.Error))
+			if next := m.advanceStep(); next != nil {
+				cmds = append(cmds, func() tea.Msg { return next })
+			}
+		}
+
 	case ChaosErrorMsg:
 		m.state = StateError
+		m.chaosFailed = true
+		m.recoveryStepIndex = msg.StepIndex
 		// Format chaos error using the template
 		severityLevel := chaos.SeverityCritical // Default to critical
 		errorOutput := msg.Template.FormatError(severityLevel)
@@ -478,6 +635,15 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Removed old progress.FrameMsg handling for npm-style renderer
 
 	case ProgressTickMsg:
+		// Show a step held up by a chaos delay as stalling
+		if m.chaosTracker != nil && m.renderer != nil {
+			stepIndex, injecting := m.chaosTracker.InjectingStep()
+			if !injecting {
+				stepIndex = -1
+			}
+			m.renderer.SetStallingStep(stepIndex)
+		}
+
 		// Update npm-style renderer during step execution
 		if m.state == StateExecuting && m.tracker != nil && !m.tracker.IsCompleted() {
 			currentStep := m.tracker.CurrentStep()
@@ -489,21 +655,13 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if currentStep < len(m.tracker.GetSteps()) {
 					// Use the tracker's internal step timing
 					stepStart := m.tracker.GetStepStart()
-					elapsed := time.Since(stepStart)
-					stepProgress = float64(elapsed) / float64(stepInfo.Duration)
-
-					if stepProgress > 1.0 {
-						stepProgress = 1.0
-					}
-					if stepProgress < 0 {
-						stepProgress = 0.0
-					}
+					stepProgress = stepInfo.ProgressAt(time.Since(stepStart))
 				}
 
 				// Update the renderer
 				m.renderer.SetCurrentStep(currentStep)
 				// Only update progress for steps that haven't been completed yet
-				m.renderer.UpdateStep(currentStep, stepProgress, stepInfo.Message, m.getSubSteps(stepInfo.Name))
+				m.renderer.UpdateStep(currentStep, stepProgress, stepInfo.MessageAt(stepProgress), m.getSubSteps(stepInfo.Name))
 				// Update component statuses based on step progress
 				m.renderer.UpdateComponentStatuses(stepInfo.Name, stepProgress)
 			}
@@ -523,6 +681,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.verbosityConfig != nil {
 				m.verbosityConfig.DebugPrint("GenerateAARMsg received - starting AAR generation")
 			}
+			m.recordChaosDifficulty()
 			cmds = append(cmds, func() tea.Msg {
 				summary, err := m.aarGenerator.Generate()
 				if err != nil {
@@ -538,7 +697,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 
 				// Format the AAR output
-				formatter := aar.NewStandardFormatter(m.width)
+				formatter := m.newAARFormatter()
 				output := formatter.Format(summary)
 
 				return DisplayAARMsg{
@@ -560,8 +719,23 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Store AAR to be printed after TUI exits
 		m.aarOutput = msg.Output
+		m.aarSummary = msg.AAR
 		m.showAAR = true
 
+		// Offer the completion menu instead of quitting after a clean run
+		if m.interactiveComplete && msg.AAR != nil && m.failedSteps == 0 {
+			m.completionMenu = NewCompletionMenu(msg.Output, devCommandFrom(msg.AAR.Commands(), m.target))
+			return m, nil
+		}
+
+		// Let the user browse and copy suggested commands before exiting
+		if m.browseCommands && msg.AAR != nil {
+			if commands := msg.AAR.Commands(); len(commands) > 0 {
+				m.commandPicker = NewCommandPicker(commands)
+				return m, nil
+			}
+		}
+
 		// Quit immediately - the AAR will be printed when the program exits
 		return m, tea.Quit
 	}
@@ -582,12 +756,28 @@ func (m *AppModel) View() string {
 		return m.promptOrchestrator.View()
 	}
 
+	// Post-completion menu
+	if m.state == StateComplete && m.completionMenu != nil {
+		return m.completionMenu.View()
+	}
+
+	// Post-completion command browsing
+	if m.state == StateComplete && m.commandPicker != nil {
+		return m.commandPicker.View()
+	}
+
 	// Handle error state - display the error prominently
 	if m.state == StateError && m.error != nil {
 		var output strings.Builder
 		output.WriteString(m.error.Error())
 		output.WriteString("\n\n")
 
+		// Show learner-requested recovery assistance
+		if recovery := m.renderRecoveryAssistance(); recovery != "" {
+			output.WriteString(recovery)
+			output.WriteString("\n\n")
+		}
+
 		// Add footer
 		footer := m.renderFooter()
 		if footer != "" {
@@ -597,6 +787,11 @@ func (m *AppModel) View() string {
 		return output.String()
 	}
 
+	// Fall back to a single-line view rather than rendering a garbled table
+	if m.width < m.minimumWidth() {
+		return m.renderNarrow()
+	}
+
 	var output strings.Builder
 
 	// Use npm-style renderer for main progress display
@@ -618,14 +813,315 @@ func (m *AppModel) View() string {
 	return output.String()
 }
 
-// GetAAROutput returns the stored AAR output for post-TUI display
+// GetAARSummary returns the generated AAR, or nil if the run produced none
+func (m *AppModel) GetAARSummary() *aar.AARSummary {
+	return m.aarSummary
+}
+
+// GetAAROutput returns the stored AAR output for post-TUI display, or "" when
+// the AAR is turned off
 func (m *AppModel) GetAAROutput() string {
-	if m.showAAR {
+	if m.showAAR && m.aarDisplay != aar.DisplayOff {
 		return m.aarOutput
 	}
 	return ""
 }
 
+// RunOutcome summarizes how far a run got and whether it succeeded
+type RunOutcome struct {
+	Status         string `json:"status"` // "success", "completed_with_errors", "failed" or "incomplete"
+	CompletedSteps int    `json:"completed_steps"`
+	TotalSteps     int    `json:"total_steps"`
+	Error          string `json:"error,omitempty"`
+}
+
+// GetRunOutcome reports the run's final status for manifests and scripting
+func (m *AppModel) GetRunOutcome() RunOutcome {
+	outcome := RunOutcome{Status: "incomplete"}
+	if m.tracker != nil {
+		outcome.CompletedSteps = m.tracker.CurrentStep()
+		outcome.TotalSteps = m.tracker.TotalSteps()
+	}
+
+	switch {
+	case m.state == StateError:
+		outcome.Status = "failed"
+		if m.error != nil {
+			outcome.Error = m.error.Error()
+		}
+	case m.completed && m.failedSteps > 0:
+		outcome.Status = "completed_with_errors"
+		outcome.CompletedSteps = outcome.TotalSteps - m.failedSteps
+		outcome.Error = fmt.Sprintf("%d step(s) failed", m.failedSteps)
+	case m.completed:
+		outcome.Status = "success"
+		outcome.CompletedSteps = outcome.TotalSteps
+	}
+
+	return outcome
+}
+
+// RunHeadless drives the tracker to completion without the TUI or step delays
+// and returns the formatted AAR
+func (m *AppModel) RunHeadless() (string, error) {
+	if m.tracker == nil {
+		return "", fmt.Errorf("no tracker available for command %q", m.command)
+	}
+
+	m.tracker.Start()
+	m.state = StateExecuting
+
+	for !m.tracker.IsCompleted() {
+		if !m.completeStepHeadless() {
+			break
+		}
+	}
+
+	summary, err := m.finishHeadless()
+	if err != nil {
+		return "", err
+	}
+
+	formatter := m.newAARFormatter()
+	return formatter.Format(summary), m.error
+}
+
+// completeStepHeadless finishes the current step outside the TUI, applying
+// chaos and the step's executor, and reports whether the run should go on
+func (m *AppModel) completeStepHeadless() bool {
+	stepIndex := m.tracker.CurrentStep()
+	stepInfo := m.tracker.CurrentStepInfo()
+	if stepInfo == nil {
+		return false
+	}
+
+	// Chaos can still fail a step in headless mode
+	if m.chaosTracker != nil {
+		result := m.chaosTracker.ExecuteStep(stepIndex)
+		m.chaosTracker.AdaptDifficulty()
+		if result.ChaosInjected && !result.Success && m.keepGoing {
+			m.failedSteps++
+			m.aarGenerator.RecordStep(stepInfo.Name, aar.StepStatusFailed, 0, result.ErrorMessage)
+			m.tracker.FailStep(errors.New(result.ErrorMessage))
+			m.tracker.NextStep()
+			return true
+		}
+		if result.ChaosInjected && !result.Success {
+			m.aarGenerator.RecordStep(stepInfo.Name, aar.StepStatusFailed, 0, result.ErrorMessage)
+			m.tracker.Fail(errors.New(result.ErrorMessage))
+			m.state = StateError
+			m.error = fmt.Errorf("chaos injection in step '%s': %s", stepInfo.Name, result.ErrorMessage)
+			return false
+		}
+	}
+
+	if err := m.tracker.ExecuteStep(context.Background(), stepIndex); err != nil {
+		m.aarGenerator.RecordStep(stepInfo.Name, aar.StepStatusFailed, 0, err.Error())
+		if m.keepGoing {
+			m.failedSteps++
+			m.tracker.NextStep()
+			return true
+		}
+		m.tracker.Fail(err)
+		m.state = StateError
+		m.error = err
+		return false
+	}
+
+	m.aarGenerator.RecordStep(stepInfo.Name, aar.StepStatusSuccess, 0, "")
+	m.tracker.NextStep()
+	return true
+}
+
+// finishHeadless settles the final state of a headless run and generates its AAR
+func (m *AppModel) finishHeadless() (*aar.AARSummary, error) {
+	if m.state != StateError {
+		m.state = StateComplete
+		m.completed = true
+	}
+
+	m.recordChaosDifficulty()
+	summary, err := m.aarGenerator.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate AAR: %w", err)
+	}
+	m.aarSummary = summary
+	return summary, nil
+}
+
+// WriteFinalFrame writes the last rendered progress table to a file,
+// optionally stripped of ANSI color codes
+func (m *AppModel) WriteFinalFrame(path string, plain bool) error {
+	if m.renderer == nil {
+		return fmt.Errorf("no renderer available")
+	}
+
+	width := m.width
+	if width == 0 {
+		width = DefaultTerminalWidth // Match template width when no window size was reported
+	}
+
+	frame := m.renderer.Render(width) + "\n"
+	if plain {
+		frame = styles.StripANSI(frame)
+	}
+
+	if err := os.WriteFile(path, []byte(frame), 0644); err != nil {
+		return fmt.Errorf("failed to write render file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// SetColorEnabled controls whether the progress table and AAR contain ANSI colors
+func (m *AppModel) SetColorEnabled(enabled bool) {
+	m.colorDisabled = !enabled
+	if m.renderer != nil {
+		m.renderer.SetColorEnabled(enabled)
+	}
+}
+
+// SetMaxStepsDisplay limits the progress table to a scrolling window of n steps
+func (m *AppModel) SetMaxStepsDisplay(n int) {
+	m.maxStepsDisplay = n
+	if m.renderer != nil {
+		m.renderer.SetMaxVisibleSteps(n)
+	}
+}
+
+// SetSeparator sets the rune used for separator lines in the progress table and AAR
+func (m *AppModel) SetSeparator(sep rune) {
+	m.separator = sep
+	if m.renderer != nil {
+		m.renderer.SetSeparator(sep)
+	}
+}
+
+// SetTheme sets the palette for the progress table and AAR (nil = styles.DefaultTheme)
+func (m *AppModel) SetTheme(theme *styles.Theme) {
+	m.theme = theme
+	if m.renderer != nil {
+		m.renderer.SetTheme(theme)
+	}
+}
+
+// newAARFormatter creates the AAR formatter with the model's display settings
+func (m *AppModel) newAARFormatter() aar.OutputFormatter {
+	standard := aar.NewStandardFormatter(m.width)
+	standard.SetColorEnabled(!m.colorDisabled)
+	standard.SetTheme(m.theme)
+	if m.separator != 0 {
+		standard.SetSeparator(m.separator)
+	}
+	if m.compactAAR {
+		return &aar.CompactFormatter{StandardFormatter: standard}
+	}
+	return standard
+}
+
+// stepLingerIncrement is how much longer '+' makes the running step
+const stepLingerIncrement = 2 * time.Second
+
+// lingerOnCurrentStep extends the running step's duration by stepLingerIncrement
+func (m *AppModel) lingerOnCurrentStep() {
+	if m.tracker == nil {
+		return
+	}
+	stepInfo := m.tracker.CurrentStepInfo()
+	if stepInfo == nil {
+		return
+	}
+	if err := m.tracker.SetStepDuration(m.tracker.CurrentStep(), stepInfo.Duration+stepLingerIncrement); err != nil && m.verbosityConfig != nil {
+		m.verbosityConfig.DebugPrint("Could not extend step: %v", err)
+	}
+}
+
+// SetKeepGoing continues past failed steps, collecting every failure in the
+// AAR, instead of stopping at the first one
+func (m *AppModel) SetKeepGoing(keepGoing bool) {
+	m.keepGoing = keepGoing
+}
+
+// SetAARDisplay controls whether the AAR prints after the run; the AAR is
+// still generated for reports either way
+func (m *AppModel) SetAARDisplay(mode aar.DisplayMode) {
+	m.aarDisplay = mode
+}
+
+// SetCompactAAR switches the AAR to the few-line compact summary
+func (m *AppModel) SetCompactAAR(compact bool) {
+	m.compactAAR = compact
+}
+
+// SetComponentSuccessRateFactor scales every component's success rate by factor
+func (m *AppModel) SetComponentSuccessRateFactor(factor float64) {
+	m.componentSuccessRate = factor
+	m.componentSuccessRateSet = true
+	if m.renderer != nil {
+		m.renderer.SetComponentSuccessRateFactor(factor)
+	}
+}
+
+// SetComponentWindows overrides when testing-phase components install; the
+// windows are expected to come from components.LoadComponentWindows
+func (m *AppModel) SetComponentWindows(windows map[string]components.ComponentWindow) {
+	m.componentWindows = windows
+	if m.renderer != nil {
+		_ = m.renderer.SetComponentWindows(windows)
+	}
+}
+
+// SetSeed seeds the base simulation's RNG independently of the chaos injector
+func (m *AppModel) SetSeed(seed int64) {
+	m.seed = seed
+	if m.tracker != nil {
+		m.tracker.SetSeed(seed)
+	}
+}
+
+// SetChaosTraceFile appends the stack trace of every chaos-failed step to path
+func (m *AppModel) SetChaosTraceFile(path string) {
+	m.chaosTraceFile = path
+	if m.chaosTracker != nil {
+		m.chaosTracker.SetTraceFile(path)
+	}
+}
+
+// GetChaosTraceFileError returns the first error hit while writing the chaos trace file
+func (m *AppModel) GetChaosTraceFileError() error {
+	if m.chaosTracker == nil {
+		return nil
+	}
+	return m.chaosTracker.GetTraceFileError()
+}
+
+// recordChaosDifficulty hands the chaos difficulty curve, if any, to the AAR
+func (m *AppModel) recordChaosDifficulty() {
+	if m.chaosTracker == nil || m.aarGenerator == nil {
+		return
+	}
+	history := m.chaosTracker.GetChaosMetrics().AdaptationHistory
+	m.aarGenerator.SetChaosDifficulty(chaos.FormatDifficultyCurve(history, m.startTime))
+}
+
+// ExportChaosMetrics writes the run's chaos metrics to path so a later run
+// can preview difficulty from this session's behavior; runs without chaos
+// write nothing
+func (m *AppModel) ExportChaosMetrics(path string) error {
+	if m.chaosTracker == nil {
+		return nil
+	}
+	return m.chaosTracker.ExportMetrics(path)
+}
+
+// GetChaosDryRunLog returns the would-be chaos injections recorded during a dry run
+func (m *AppModel) GetChaosDryRunLog() []chaos.DryRunEvent {
+	if m.chaosTracker == nil {
+		return nil
+	}
+	return m.chaosTracker.GetDryRunLog()
+}
+
 // getSubSteps returns sub-steps for the current step
 func (m *AppModel) getSubSteps(stepName string) []string {
 	switch stepName {
@@ -674,13 +1170,174 @@ func (m *AppModel) getSubSteps(stepName string) []string {
 	}
 }
 
+// SetBrowseCommands keeps the program open after completion to browse and copy suggested commands
+func (m *AppModel) SetBrowseCommands(enabled bool) {
+	m.browseCommands = enabled
+}
+
+// handleCommandPickerKey navigates and copies suggested commands on the completion screen
+func (m *AppModel) handleCommandPickerKey(key string) tea.Cmd {
+	switch key {
+	case "up", "k":
+		m.commandPicker.Prev()
+	case "down", "j", "tab":
+		m.commandPicker.Next()
+	case "enter":
+		m.commandPicker.Copy()
+	case "q", "esc":
+		return tea.Quit
+	}
+	return nil
+}
+
+// SetInteractiveComplete shows a menu after a successful run instead of quitting
+func (m *AppModel) SetInteractiveComplete(enabled bool) {
+	m.interactiveComplete = enabled
+}
+
+// handleCompletionMenuKey navigates the completion menu and dispatches the selected action
+func (m *AppModel) handleCompletionMenuKey(key string) tea.Cmd {
+	switch key {
+	case "up", "k":
+		m.completionMenu.Prev()
+	case "down", "j", "tab":
+		m.completionMenu.Next()
+	case "q", "esc":
+		return tea.Quit
+	case "enter":
+		switch m.completionMenu.Selected() {
+		case CompletionViewAAR:
+			m.completionMenu.ToggleAAR()
+		case CompletionSaveReport:
+			m.completionMenu.SaveReport()
+		case CompletionRerun:
+			return m.rerun()
+		case CompletionCopyDevCommand:
+			m.completionMenu.CopyDevCommand()
+		case CompletionQuit:
+			return tea.Quit
+		}
+	}
+	return nil
+}
+
+// rerun resets the run state and executes the same configuration again
+func (m *AppModel) rerun() tea.Cmd {
+	m.completionMenu = nil
+	m.aarOutput = ""
+	m.aarSummary = nil
+	m.showAAR = false
+	m.completed = false
+	m.failedSteps = 0
+	m.currentStep = 0
+	m.startTime = time.Now()
+
+	// Give the re-run the chaos a fresh run would get: a new chaos window, a
+	// full injection budget and no chained failures left from the last run
+	if m.chaosTracker != nil {
+		if injector := m.chaosTracker.GetChaosInjector(); injector != nil {
+			injector.ResetState()
+		}
+	}
+
+	// Fresh tracker, renderer and AAR generator; the progress ticker is still running
+	m.updateComponentsFromConfig()
+	return m.startExecution()
+}
+
+// handleRecoveryKey reveals recovery assistance when the user asks for it
+func (m *AppModel) handleRecoveryKey(key string) {
+	if m.chaosTracker == nil {
+		return
+	}
+
+	switch key {
+	case "?":
+		if hint, err := m.chaosTracker.RequestHint(m.recoveryStepIndex); err == nil {
+			m.recoveryHint = hint
+		}
+	case "!":
+		if solution, err := m.chaosTracker.RequestSolution(m.recoveryStepIndex); err == nil {
+			m.recoverySolution = solution
+		}
+	}
+}
+
+// renderRecoveryAssistance renders any hint or solution the user has requested
+func (m *AppModel) renderRecoveryAssistance() string {
+	var lines []string
+	if m.recoveryHint != "" {
+		lines = append(lines, styles.InfoStyle.Render(m.recoveryHint))
+	}
+	if m.recoverySolution != "" {
+		lines = append(lines, styles.InfoStyle.Render(m.recoverySolution))
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Removed old render methods - using npm-style renderer instead
 
+// SetMinWidth sets the narrowest terminal width the enhanced layout is used for
+func (m *AppModel) SetMinWidth(width int) {
+	m.minWidth = width
+}
+
+// SetForcedWidth pins the progress table and AAR to a fixed width regardless
+// of the terminal size (0 = follow the terminal)
+func (m *AppModel) SetForcedWidth(width int) {
+	m.forcedWidth = width
+	if width > 0 {
+		m.width = width
+	}
+}
+
+// layoutWidth returns the forced width when set, otherwise the reported one
+func (m *AppModel) layoutWidth(reported int) int {
+	if m.forcedWidth > 0 {
+		return m.forcedWidth
+	}
+	return reported
+}
+
+// minimumWidth returns the configured minimum width or the default
+func (m *AppModel) minimumWidth() int {
+	if m.minWidth <= 0 {
+		return DefaultMinTerminalWidth
+	}
+	return m.minWidth
+}
+
+// renderNarrow renders a compact progress line for terminals below the minimum width
+func (m *AppModel) renderNarrow() string {
+	var output strings.Builder
+	output.WriteString(styles.WarningStyle.Render(fmt.Sprintf("Terminal too narrow; widen to ≥%d columns", m.minimumWidth())))
+	output.WriteString("\n")
+
+	switch m.state {
+	case StateComplete:
+		output.WriteString(fmt.Sprintf("%s %s created", styles.CurrentGlyphs().Check, m.target))
+	default:
+		step := m.currentStep + 1
+		if step > m.totalSteps {
+			step = m.totalSteps
+		}
+		output.WriteString(fmt.Sprintf("[%d/%d] %s", step, m.totalSteps, m.stepName))
+	}
+
+	return output.String()
+}
+
 func (m *AppModel) renderFooter() string {
 	switch m.state {
 	case StateComplete:
+		if m.failedSteps > 0 {
+			return styles.WarningStyle.Render(fmt.Sprintf("⚠️  Completed with errors: %d step(s) failed", m.failedSteps))
+		}
 		return styles.SuccessStyle.Render("✨ Success! Application created successfully")
 	case StateError:
+		if m.chaosFailed {
+			return styles.ErrorStyle.Render("💡 Press ? for a hint, ! for the full solution. Press Ctrl+C to exit")
+		}
 		return styles.ErrorStyle.Render("💡 Check output above for troubleshooting. Press Ctrl+C to exit")
 	default:
 		return styles.MutedStyle.Render("Press Ctrl+C to quit")
@@ -706,6 +1363,13 @@ type ChaosErrorMsg struct {
 
 type ProgressTickMsg struct{}
 
+// StepFailedMsg reports a failed step that keep-going mode will skip past
+type StepFailedMsg struct {
+	StepIndex int
+	StepName  string
+	Error     string
+}
+
 type StepCheckMsg struct{}
 
 // GenerateAARMsg triggers AAR generation
@@ -733,6 +1397,7 @@ func (m *AppModel) updateProgressBar() tea.Cmd {
 func (m *AppModel) startExecution() tea.Cmd {
 	if m.tracker != nil {
 		m.tracker.Start()
+		m.stepStartedAt = time.Now()
 		m.totalSteps = m.tracker.TotalSteps()
 		m.state = StateExecuting
 		return m.nextStep()
@@ -759,11 +1424,22 @@ func (m *AppModel) nextStep() tea.Cmd {
 			if m.chaosTracker != nil {
 				// Execute step with chaos checking
 				result := m.chaosTracker.ExecuteStep(m.tracker.CurrentStep())
+				m.chaosTracker.AdaptDifficulty()
+
+				// In keep-going mode record the failure and move on
+				if result.ChaosInjected && !result.Success && m.keepGoing {
+					return StepFailedMsg{
+						StepIndex: result.StepIndex,
+						StepName:  result.StepName,
+						Error:     result.ErrorMessage,
+					}
+				}
 
 				// If chaos was injected and failed, display error
 				if result.ChaosInjected && !result.Success {
 					errorTemplate := m.chaosTracker.GenerateErrorTemplate(m.tracker.CurrentStep(), result)
 					if errorTemplate != nil {
+						m.tracker.Fail(errors.New(result.ErrorMessage))
 						return ChaosErrorMsg{
 							Template: errorTemplate,
 							StepName: result.StepName,
@@ -773,23 +1449,21 @@ func (m *AppModel) nextStep() tea.Cmd {
 				}
 			}
 
-			// Advance to next step
-			if !m.tracker.NextStep() {
-				// All steps complete
-				return ProgressMsg{
-					Step:     m.tracker.TotalSteps(),
-					StepName: "Complete",
-					Message:  "✨ All steps completed successfully!",
+			// Run the step's real work, if any, once its simulated time is up
+			if err := m.tracker.ExecuteStep(context.Background(), m.tracker.CurrentStep()); err != nil {
+				if m.keepGoing {
+					return StepFailedMsg{
+						StepIndex: m.tracker.CurrentStep(),
+						StepName:  m.tracker.CurrentStepInfo().Name,
+						Error:     err.Error(),
+					}
 				}
+				m.tracker.Fail(err)
+				return ErrorMsg{Error: err}
 			}
 
-			// Get current step info
-			if stepInfo := m.tracker.CurrentStepInfo(); stepInfo != nil {
-				return ProgressMsg{
-					Step:     m.tracker.CurrentStep(),
-					StepName: stepInfo.Name,
-					Message:  stepInfo.Message,
-				}
+			if msg := m.advanceStep(); msg != nil {
+				return msg
 			}
 		}
 
@@ -798,6 +1472,37 @@ func (m *AppModel) nextStep() tea.Cmd {
 	})
 }
 
+// completionMessage summarizes the finished run, noting steps that failed
+// under --keep-going
+func (m *AppModel) completionMessage() string {
+	if m.failedSteps > 0 {
+		return fmt.Sprintf("⚠️  All steps finished, %d step(s) failed", m.failedSteps)
+	}
+	return "✨ All steps completed successfully!"
+}
+
+// advanceStep moves the tracker to the next step and reports the new position
+func (m *AppModel) advanceStep() tea.Msg {
+	if !m.tracker.NextStep() {
+		// All steps complete
+		return ProgressMsg{
+			Step:     m.tracker.TotalSteps(),
+			StepName: "Complete",
+			Message:  m.completionMessage(),
+		}
+	}
+
+	// Get current step info
+	if stepInfo := m.tracker.CurrentStepInfo(); stepInfo != nil {
+		return ProgressMsg{
+			Step:     m.tracker.CurrentStep(),
+			StepName: stepInfo.Name,
+			Message:  stepInfo.MessageAt(0),
+		}
+	}
+	return nil
+}
+
 // validateAndStartExecution validates user configuration and starts execution
 func (m *AppModel) validateAndStartExecution() tea.Cmd {
 	if m.userConfig == nil {
@@ -824,10 +1529,7 @@ func (m *AppModel) updateComponentsFromConfig() {
 	}
 
 	// Create tracker first to get the exact step names
-	devOnly := !m.userConfig.ProductionSetup.Docker &&
-		!m.userConfig.ProductionSetup.CI_CD &&
-		!m.userConfig.ProductionSetup.Monitoring &&
-		!m.userConfig.ProductionSetup.Analytics
+	devOnly := m.userConfig.ProductionSetup.IsDevOnly()
 
 	// Create new tracker with updated configuration
 	tempTracker := progresssim.NewCreateTracker(devOnly)
@@ -851,22 +1553,61 @@ func (m *AppModel) updateComponentsFromConfig() {
 
 	// Create new tracker with updated configuration
 	m.tracker = progresssim.NewCreateTracker(devOnly)
+	m.tracker.SetSeed(m.seed)
 	m.totalSteps = m.tracker.TotalSteps()
 
 	// If chaos tracker exists, wrap the new tracker
 	if m.chaosTracker != nil {
 		m.chaosTracker = chaos.NewChaosAwareTracker(m.tracker, m.chaosTracker.GetChaosInjector())
+		m.chaosTracker.SetTraceFile(m.chaosTraceFile)
 	}
 
-	// Create new renderer with user configuration
-	appName := m.target
+	// Create new renderer listing only the components the user selected
 	targetDir := fmt.Sprintf("./%s", m.target)
-	template := m.userConfig.Template.Type.String()
-	m.renderer = components.NewEnhancedRenderer(appName, targetDir, template, stepNames, devOnly)
+	m.renderer = components.NewEnhancedRendererFromConfig(m.target, targetDir, m.userConfig, stepNames)
+	m.renderer.SetColorEnabled(!m.colorDisabled)
+	m.renderer.SetTracker(m.tracker)
+	if m.verbosityConfig != nil {
+		m.renderer.SetShowSubSteps(m.verbosityConfig.ShouldShow("substeps"))
+		m.renderer.SetShowStepDurations(m.verbosityConfig.ShouldShowDetailLevel(4))
+		m.renderer.SetVerbosity(m.verbosityConfig)
+	}
+	m.renderer.SetMaxVisibleSteps(m.maxStepsDisplay)
+	if m.separator != 0 {
+		m.renderer.SetSeparator(m.separator)
+	}
+	if m.theme != nil {
+		m.renderer.SetTheme(m.theme)
+	}
+	if m.componentSuccessRateSet {
+		m.renderer.SetComponentSuccessRateFactor(m.componentSuccessRate)
+	}
+	if m.componentWindows != nil {
+		_ = m.renderer.SetComponentWindows(m.componentWindows)
+	}
 
 	// Update AAR generator with proper user configuration
 	projectPath := fmt.Sprintf("./%s", m.target)
 	m.aarGenerator = aar.NewAARGenerator(m.tracker, m.userConfig, m.startTime, projectPath)
+	m.aarGenerator.SetEstimatedDuration(estimatedRunDuration(m.tracker))
+	m.aarGenerator.SetConfigWarnings(configurationWarnings(m.userConfig))
+}
+
+// configurationWarnings returns the pre-run configuration warnings for the AAR
+func configurationWarnings(userConfig *config.UserConfiguration) []string {
+	if userConfig == nil {
+		return nil
+	}
+	return config.ValidateConfiguration(*userConfig)
+}
+
+// estimatedRunDuration is the tracker's planned duration, for the AAR's
+// estimate vs actual comparison
+func estimatedRunDuration(tracker *progresssim.Tracker) time.Duration {
+	if tracker == nil {
+		return 0
+	}
+	return tracker.TotalEstimatedDuration()
 }
 
 // NewAppModelWithChaos creates a new app model with chaos injection capabilities
@@ -0,0 +1,447 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
+	progresssim "github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testSeed makes the base simulation's step outcomes succeed deterministically
+const testSeed = 1
+
+// newTestAppModel builds a colorless create model with default answers and a fixed seed
+func newTestAppModel(t *testing.T, flags ...string) *AppModel {
+	t.Helper()
+
+	userConfig := prompts.DefaultUserConfiguration()
+	userConfig.ProjectName = "TestApp"
+
+	m := NewAppModelWithVerbosity("create", "TestApp", flags, userConfig, config.NewVerbosityConfig(config.VerbosityDefault))
+	m.SetColorEnabled(false)
+	m.SetSeed(testSeed)
+	return m
+}
+
+func TestWriteFinalFrameContainsComponentsAndSeparators(t *testing.T) {
+	m := newTestAppModel(t)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "frame.txt")
+	if err := m.WriteFinalFrame(path, true); err != nil {
+		t.Fatalf("WriteFinalFrame() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading render file: %v", err)
+	}
+	frame := string(data)
+
+	for _, want := range []string{"Quality & Testing:", strings.Repeat("-", DefaultTerminalWidth)} {
+		if !strings.Contains(frame, want) {
+			t.Errorf("render file is missing %q:\n%s", want, frame)
+		}
+	}
+	if strings.Contains(frame, "\x1b[") {
+		t.Error("plain render file still contains ANSI escape codes")
+	}
+
+	lines := strings.Split(strings.TrimRight(frame, "\n"), "\n")
+	if last := lines[len(lines)-1]; !strings.HasPrefix(last, "---") {
+		t.Errorf("render file ends with %q, want the closing separator", last)
+	}
+}
+
+func TestRunHeadlessPrintsOnlyTheAAR(t *testing.T) {
+	m := newTestAppModel(t)
+
+	output, err := m.RunHeadless()
+	if err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	if !strings.Contains(output, "AFTER ACTION SUMMARY") {
+		t.Errorf("headless output is missing the AAR header:\n%s", output)
+	}
+	for _, frame := range []string{"Total Progress", "[queued]", "Press Ctrl+C"} {
+		if strings.Contains(output, frame) {
+			t.Errorf("headless output contains progress frame text %q", frame)
+		}
+	}
+	if !m.tracker.IsCompleted() {
+		t.Error("tracker is not completed after RunHeadless()")
+	}
+}
+
+func TestAARDisplayModes(t *testing.T) {
+	tests := []struct {
+		mode    aar.DisplayMode
+		wantAAR bool
+	}{
+		{mode: aar.DisplayOn, wantAAR: true},
+		{mode: aar.DisplayOff, wantAAR: false},
+		{mode: aar.DisplayOnly, wantAAR: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			m := newTestAppModel(t)
+			m.SetAARDisplay(tt.mode)
+
+			output, err := m.RunHeadless()
+			if err != nil {
+				t.Fatalf("RunHeadless() error = %v", err)
+			}
+			if m.GetAARSummary() == nil {
+				t.Error("AAR summary was not generated for reports")
+			}
+
+			// The TUI path stores the AAR and prints it after the program exits
+			m.Update(DisplayAARMsg{AAR: m.GetAARSummary(), Output: output})
+			got := m.GetAAROutput()
+			if tt.wantAAR && !strings.Contains(got, "AFTER ACTION SUMMARY") {
+				t.Errorf("GetAAROutput() = %q, want the AAR", got)
+			}
+			if !tt.wantAAR && got != "" {
+				t.Errorf("GetAAROutput() = %q, want no AAR", got)
+			}
+		})
+	}
+}
+
+func TestViewFallsBackBelowMinimumWidth(t *testing.T) {
+	tests := []struct {
+		name       string
+		minWidth   int
+		width      int
+		wantNarrow bool
+	}{
+		{name: "default minimum, narrow terminal", width: DefaultMinTerminalWidth - 1, wantNarrow: true},
+		{name: "default minimum, wide terminal", width: DefaultTerminalWidth},
+		{name: "raised minimum", minWidth: 100, width: DefaultTerminalWidth, wantNarrow: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestAppModel(t)
+			m.SetMinWidth(tt.minWidth)
+			m.state = StateExecuting
+			m.width = tt.width
+
+			view := m.View()
+			narrow := strings.Contains(view, "Terminal too narrow")
+			if narrow != tt.wantNarrow {
+				t.Fatalf("narrow view = %t, want %t:\n%s", narrow, tt.wantNarrow, view)
+			}
+			if narrow && strings.Contains(view, "Total Progress") {
+				t.Error("narrow view still renders the progress table")
+			}
+		})
+	}
+}
+
+func TestAAREstimateIsTrackerPlannedDuration(t *testing.T) {
+	m := newTestAppModel(t)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	summary := m.GetAARSummary()
+	if summary == nil {
+		t.Fatal("GetAARSummary() = nil after a headless run")
+	}
+	if got, want := summary.ExecutionInfo.EstimatedDuration, m.tracker.TotalEstimatedDuration(); got != want {
+		t.Errorf("AAR EstimatedDuration = %s, want the tracker's planned %s", got, want)
+	}
+}
+
+func TestKeepGoingReportsEveryFailedStep(t *testing.T) {
+	m := newTestAppModel(t)
+	m.SetKeepGoing(true)
+
+	failing := progresssim.StepExecutorFunc(func(context.Context) error {
+		return errors.New("boom")
+	})
+	m.tracker.GetStep(1).Executor = failing
+	m.tracker.GetStep(3).Executor = failing
+
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v, want keep-going to finish the run", err)
+	}
+
+	summary := m.GetAARSummary()
+	if summary == nil {
+		t.Fatal("GetAARSummary() = nil after a headless run")
+	}
+	if got := summary.ExecutionInfo.FailedSteps; got != 2 {
+		t.Errorf("AAR FailedSteps = %d, want 2", got)
+	}
+
+	footer := m.renderFooter()
+	if strings.Contains(footer, "Success") {
+		t.Errorf("footer = %q, want a completed-with-errors notice", footer)
+	}
+	if !strings.Contains(footer, "2 step(s) failed") {
+		t.Errorf("footer = %q, want it to count both failed steps", footer)
+	}
+	if msg := m.completionMessage(); !strings.Contains(msg, "2 step(s) failed") {
+		t.Errorf("completionMessage() = %q, want it to count both failed steps", msg)
+	}
+}
+
+func TestCompletionMessageWithoutFailures(t *testing.T) {
+	m := newTestAppModel(t)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	if footer := m.renderFooter(); !strings.Contains(footer, "Success") {
+		t.Errorf("footer = %q, want the success notice", footer)
+	}
+	if msg := m.completionMessage(); !strings.Contains(msg, "completed successfully") {
+		t.Errorf("completionMessage() = %q, want the success message", msg)
+	}
+}
+
+func TestAARRecordsConfigurationWarnings(t *testing.T) {
+	userConfig := prompts.DefaultUserConfiguration()
+	userConfig.ProjectName = "TestApp"
+	userConfig.ProductionSetup.Docker = true
+	userConfig.ProductionSetup.CI_CD = false
+
+	m := NewAppModelWithVerbosity("create", "TestApp", nil, userConfig, config.NewVerbosityConfig(config.VerbosityDefault))
+	m.SetColorEnabled(false)
+	m.SetSeed(testSeed)
+
+	output, err := m.RunHeadless()
+	if err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	const want = "Docker setup is recommended with CI/CD pipeline"
+	summary := m.GetAARSummary()
+	found := false
+	for _, warning := range summary.Warnings {
+		found = found || strings.Contains(warning, want)
+	}
+	if !found {
+		t.Errorf("AAR Warnings = %q, want the Docker-without-CI/CD warning", summary.Warnings)
+	}
+
+	idx := strings.Index(output, "Configuration warnings:")
+	if idx < 0 || !strings.Contains(output[idx:], want) {
+		t.Errorf("AAR output has no warnings section listing %q:\n%s", want, output)
+	}
+}
+
+func TestFailingExecutorFailsRun(t *testing.T) {
+	errScaffold := errors.New("scaffold: permission denied")
+	m := newTestAppModel(t)
+	m.tracker.GetStep(1).Executor = progresssim.StepExecutorFunc(func(context.Context) error {
+		return errScaffold
+	})
+
+	_, err := m.RunHeadless()
+	if !errors.Is(err, errScaffold) {
+		t.Fatalf("RunHeadless() error = %v, want the executor's error", err)
+	}
+
+	results := m.GetAARSummary().StepResults
+	if len(results) != 2 {
+		t.Fatalf("AAR recorded %d steps, want the run to stop at the failed second step", len(results))
+	}
+	if results[1].Status != aar.StepStatusFailed || !strings.Contains(results[1].ErrorMessage, errScaffold.Error()) {
+		t.Errorf("failed step result = %+v, want failed with the executor's error", results[1])
+	}
+}
+
+func TestFailFastRunReportsFailedStepOnce(t *testing.T) {
+	errScaffold := errors.New("scaffold: permission denied")
+	m := newTestAppModel(t)
+	m.tracker.GetStep(1).Executor = progresssim.StepExecutorFunc(func(context.Context) error {
+		return errScaffold
+	})
+
+	results := make(chan progresssim.StepResult, 8)
+	m.tracker.OnStepComplete(func(result progresssim.StepResult) { results <- result })
+
+	if _, err := m.RunHeadless(); !errors.Is(err, errScaffold) {
+		t.Fatalf("RunHeadless() error = %v, want the executor's error", err)
+	}
+
+	wantStatus := []string{progresssim.StepSucceeded, progresssim.StepFailed}
+	for i, want := range wantStatus {
+		select {
+		case result := <-results:
+			if result.Index != i || result.Status != want {
+				t.Errorf("result %d = %+v, want step %d %s", i, result, i, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("OnStepComplete reported %d steps, want %d", i, len(wantStatus))
+		}
+	}
+
+	select {
+	case result := <-results:
+		t.Errorf("unexpected extra step result %+v after the run failed", result)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestVerboseStepTableShowsStepDurations(t *testing.T) {
+	for _, tt := range []struct {
+		level config.VerbosityLevel
+		want  bool
+	}{
+		{config.VerbosityDefault, false},
+		{config.VerbosityVerbose, true},
+	} {
+		userConfig := prompts.DefaultUserConfiguration()
+		userConfig.ProjectName = "TestApp"
+		m := NewAppModelWithVerbosity("create", "TestApp", nil, userConfig, config.NewVerbosityConfig(tt.level))
+		m.SetColorEnabled(false)
+
+		// The first step started three seconds before the model advanced past it
+		m.stepStartedAt = time.Now().Add(-3 * time.Second)
+		m.Update(ProgressMsg{Step: 1, StepName: m.tracker.GetSteps()[1].Name})
+
+		line := ""
+		for _, l := range strings.Split(m.renderer.Render(DefaultTerminalWidth), "\n") {
+			if strings.Contains(l, m.tracker.GetSteps()[0].Name) {
+				line = styles.StripANSI(l)
+				break
+			}
+		}
+		if got := strings.HasSuffix(line, " 3.0s"); got != tt.want {
+			t.Errorf("%s: completed step line %q shows its 3.0s duration = %t, want %t", tt.level, line, got, tt.want)
+		}
+	}
+}
+
+// advanceThroughSteps sends the ProgressMsgs the TUI sees during a run: each
+// names the step that starts next, and the last one names "Complete"
+func advanceThroughSteps(m *AppModel) {
+	steps := m.tracker.GetSteps()
+	for i := 1; i <= len(steps); i++ {
+		name := "Complete"
+		if i < len(steps) {
+			name = steps[i].Name
+		}
+		m.Update(ProgressMsg{Step: i, StepName: name})
+	}
+}
+
+func TestProgressMsgsRecordFinishedStepsInAAR(t *testing.T) {
+	m := newTestAppModel(t)
+	advanceThroughSteps(m)
+
+	summary, err := m.aarGenerator.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	steps := m.tracker.GetSteps()
+	if len(summary.StepResults) != len(steps) {
+		t.Fatalf("AAR recorded %d steps, want %d", len(summary.StepResults), len(steps))
+	}
+	var planned time.Duration
+	for i, step := range steps {
+		if got := summary.StepResults[i].Name; got != step.Name {
+			t.Errorf("step result %d = %q, want %q", i, got, step.Name)
+		}
+		planned += step.Duration
+	}
+	if got := summary.ExecutionInfo.SimulatedDuration; got != planned {
+		t.Errorf("SimulatedDuration = %s, want the planned total %s", got, planned)
+	}
+}
+
+func TestProgressMsgsAttributeStepDurationsInAAR(t *testing.T) {
+	m := newTestAppModel(t)
+	steps := m.tracker.GetSteps()
+
+	// The first step ran for three seconds; the rest finish immediately
+	m.stepStartedAt = time.Now().Add(-3 * time.Second)
+	advanceThroughSteps(m)
+
+	summary, err := m.aarGenerator.Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if got := summary.StepResults[0].Duration; got < 3*time.Second {
+		t.Errorf("%s duration = %s, want at least 3s", steps[0].Name, got)
+	}
+	if got := summary.ExecutionInfo.Performance.SlowestStep; got != steps[0].Name {
+		t.Errorf("SlowestStep = %q, want %q", got, steps[0].Name)
+	}
+}
+
+func TestSetThemeAppliesToRendererAndAAR(t *testing.T) {
+	m := newTestAppModel(t)
+	m.SetColorEnabled(true)
+	theme := styles.HighContrastTheme()
+	m.SetTheme(theme)
+
+	if view := m.renderer.Render(DefaultTerminalWidth); !strings.Contains(view, theme.AppName+"'TestApp'") {
+		t.Errorf("progress table does not use the high-contrast app name color:\n%q", view)
+	}
+
+	output, err := m.RunHeadless()
+	if err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+	if !strings.Contains(output, theme.Done) {
+		t.Errorf("AAR does not use the high-contrast success color:\n%q", output)
+	}
+	if strings.Contains(output, styles.DefaultTheme().Done) {
+		t.Errorf("AAR still uses the default green:\n%q", output)
+	}
+}
+
+func TestForcedWidthIgnoresReportedWindowSize(t *testing.T) {
+	const forced = 80
+	m := newTestAppModel(t)
+	m.SetForcedWidth(forced)
+
+	m.Update(tea.WindowSizeMsg{Width: 200, Height: 50})
+	m.Update(resizeSettledMsg{seq: m.resizeSeq})
+	if m.width != forced {
+		t.Fatalf("width after a 200-column WindowSizeMsg = %d, want the forced %d", m.width, forced)
+	}
+	if m.height != 50 {
+		t.Errorf("height = %d, want the reported 50", m.height)
+	}
+
+	frame := m.renderer.Render(m.width)
+	for _, line := range strings.Split(frame, "\n") {
+		if w := styles.VisibleWidth(line); w > forced {
+			t.Errorf("progress line is %d columns, want at most %d: %q", w, forced, line)
+		}
+	}
+	if !strings.Contains(styles.StripANSI(frame), "\n"+strings.Repeat("-", forced)+"\n") {
+		t.Errorf("progress table has no %d-column separator:\n%s", forced, frame)
+	}
+
+	output, err := m.RunHeadless()
+	if err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if w := styles.VisibleWidth(line); w > forced {
+			t.Errorf("AAR line is %d columns, want at most %d: %q", w, forced, line)
+		}
+	}
+}
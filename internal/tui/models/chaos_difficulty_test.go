@@ -0,0 +1,50 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
+)
+
+func TestChaosRunReportsDifficultyCurveInAAR(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	chaosConfig := chaos.NewDefaultConfig()
+	chaosConfig.Enabled = true
+	chaosConfig.RandomSeed = 1
+	chaosConfig.MaxCPUUsagePercent = 50
+	chaosConfig.AggressivenessLevel = chaos.Aggressive
+	injector, err := chaos.NewSafeChaosInjector(chaosConfig)
+	if err != nil {
+		t.Fatalf("NewSafeChaosInjector() error = %v", err)
+	}
+
+	userConfig := prompts.DefaultUserConfiguration()
+	userConfig.ProjectName = "TestApp"
+	m := NewAppModelWithChaos("create", "TestApp", nil, userConfig, config.NewVerbosityConfig(config.VerbosityDefault), injector)
+	m.SetColorEnabled(false)
+	m.SetSeed(testSeed)
+	m.SetKeepGoing(true)
+
+	output, err := m.RunHeadless()
+	if err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	// Forced injections keep failing steps, so the low success rate eases difficulty
+	for _, want := range []string{
+		"Chaos difficulty:",
+		"started at aggressive",
+		"aggressive → scout: Low success rate detected",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("AAR is missing %q:\n%s", want, output)
+		}
+	}
+	if level := injector.GetAggressivenessLevel(); level >= chaos.Aggressive {
+		t.Errorf("aggressiveness after the run = %s, want it lowered from aggressive", level)
+	}
+}
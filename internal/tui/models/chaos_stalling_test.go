@@ -0,0 +1,83 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+// blockingInjector injects into every step and holds each injection's
+// simulated delay open until released
+type blockingInjector struct {
+	*chaos.SafeChaosInjector
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingInjector) ShouldInject(string) bool { return true }
+
+func (b *blockingInjector) SelectScenario(string) *chaos.ChaosScenario {
+	return &chaos.ChaosScenario{ErrorScenario: &chaos.ErrorScenario{Type: "network_failure"}}
+}
+
+func (b *blockingInjector) InjectFailure(string, *chaos.ChaosScenario) error {
+	b.entered <- struct{}{}
+	<-b.release
+	return errors.New("registry unreachable")
+}
+
+func TestChaosDelayRendersStepAsStalling(t *testing.T) {
+	chaosConfig := chaos.NewDefaultConfig()
+	chaosConfig.Enabled = true
+	chaosConfig.MaxCPUUsagePercent = 50
+	safe, err := chaos.NewSafeChaosInjector(chaosConfig)
+	if err != nil {
+		t.Fatalf("NewSafeChaosInjector() error = %v", err)
+	}
+	injector := &blockingInjector{SafeChaosInjector: safe, entered: make(chan struct{}), release: make(chan struct{})}
+
+	userConfig := prompts.DefaultUserConfiguration()
+	userConfig.ProjectName = "TestApp"
+	m := NewAppModelWithChaos("create", "TestApp", nil, userConfig, config.NewVerbosityConfig(config.VerbosityDefault), injector)
+	m.SetColorEnabled(true)
+	m.tracker.Start()
+	m.state = StateExecuting
+
+	stepName := m.tracker.CurrentStepInfo().Name
+	yellow := styles.DefaultTheme().Warning
+	stepLine := func() string {
+		for _, line := range strings.Split(m.renderer.Render(DefaultTerminalWidth), "\n") {
+			if strings.Contains(line, stepName) {
+				return line
+			}
+		}
+		t.Fatalf("step %q is not rendered", stepName)
+		return ""
+	}
+
+	if strings.Contains(stepLine(), yellow) {
+		t.Fatalf("step is stalling before any injection: %q", stepLine())
+	}
+
+	done := make(chan *chaos.StepExecutionResult, 1)
+	go func() { done <- m.chaosTracker.ExecuteStep(m.tracker.CurrentStep()) }()
+	<-injector.entered
+
+	m.Update(ProgressTickMsg{})
+	if line := stepLine(); !strings.Contains(line, yellow) {
+		t.Errorf("step mid-injection = %q, want it drawn yellow", line)
+	}
+
+	close(injector.release)
+	<-done
+
+	m.Update(ProgressTickMsg{})
+	if line := stepLine(); strings.Contains(line, yellow) {
+		t.Errorf("step after the injection resolved = %q, want the stalling color gone", line)
+	}
+}
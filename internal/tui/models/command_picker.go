@@ -0,0 +1,92 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+// CommandPicker lets the user browse the AAR's suggested commands and copy one
+type CommandPicker struct {
+	commands []string
+	selected int
+	status   string
+}
+
+// NewCommandPicker creates a picker over the given commands
+func NewCommandPicker(commands []string) *CommandPicker {
+	return &CommandPicker{commands: commands}
+}
+
+// Next moves the highlight down, wrapping at the end
+func (cp *CommandPicker) Next() {
+	if len(cp.commands) == 0 {
+		return
+	}
+	cp.selected = (cp.selected + 1) % len(cp.commands)
+	cp.status = ""
+}
+
+// Prev moves the highlight up, wrapping at the start
+func (cp *CommandPicker) Prev() {
+	if len(cp.commands) == 0 {
+		return
+	}
+	cp.selected = (cp.selected - 1 + len(cp.commands)) % len(cp.commands)
+	cp.status = ""
+}
+
+// Selected returns the highlighted command
+func (cp *CommandPicker) Selected() string {
+	if len(cp.commands) == 0 {
+		return ""
+	}
+	return cp.commands[cp.selected]
+}
+
+// Copy copies the highlighted command to the clipboard, falling back to
+// showing the command when no clipboard is available
+func (cp *CommandPicker) Copy() {
+	command := cp.Selected()
+	if command == "" {
+		return
+	}
+
+	if clipboard.Unsupported {
+		cp.status = fmt.Sprintf("No clipboard available - copy manually: %s", command)
+		return
+	}
+	if err := clipboard.WriteAll(command); err != nil {
+		cp.status = fmt.Sprintf("Copy failed (%v) - copy manually: %s", err, command)
+		return
+	}
+	cp.status = fmt.Sprintf("Copied: %s", command)
+}
+
+// View renders the command list with the highlighted entry
+func (cp *CommandPicker) View() string {
+	var output strings.Builder
+
+	output.WriteString(styles.HeaderStyle.Render("Suggested commands"))
+	output.WriteString("\n")
+	for i, command := range cp.commands {
+		if i == cp.selected {
+			output.WriteString(styles.InfoStyle.Render("❯ " + command))
+		} else {
+			output.WriteString(styles.MutedStyle.Render("  " + command))
+		}
+		output.WriteString("\n")
+	}
+
+	if cp.status != "" {
+		output.WriteString("\n")
+		output.WriteString(styles.SuccessStyle.Render(cp.status))
+		output.WriteString("\n")
+	}
+
+	output.WriteString("\n")
+	output.WriteString(styles.MutedStyle.Render("[↑↓] Navigate • [Enter] Copy • [q] Done"))
+	return output.String()
+}
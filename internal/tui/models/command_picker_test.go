@@ -0,0 +1,86 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/atotto/clipboard"
+)
+
+func TestCommandPickerNavigation(t *testing.T) {
+	cp := NewCommandPicker([]string{"cd TestApp", "npm run dev", "npm test"})
+
+	steps := []struct {
+		move func()
+		want string
+	}{
+		{func() {}, "cd TestApp"},
+		{cp.Next, "npm run dev"},
+		{cp.Next, "npm test"},
+		{cp.Next, "cd TestApp"}, // wraps forward
+		{cp.Prev, "npm test"},   // wraps back
+		{cp.Prev, "npm run dev"},
+	}
+
+	for i, step := range steps {
+		step.move()
+		if got := cp.Selected(); got != step.want {
+			t.Errorf("step %d: Selected() = %q, want %q", i, got, step.want)
+		}
+	}
+
+	if view := cp.View(); !strings.Contains(view, "❯ npm run dev") {
+		t.Errorf("View() does not highlight the selected command:\n%s", view)
+	}
+}
+
+func TestCommandPickerCopyWithoutClipboard(t *testing.T) {
+	previous := clipboard.Unsupported
+	clipboard.Unsupported = true
+	t.Cleanup(func() { clipboard.Unsupported = previous })
+
+	cp := NewCommandPicker([]string{"cd TestApp", "npm run dev"})
+	cp.Next()
+	cp.Copy()
+
+	if want := "No clipboard available - copy manually: npm run dev"; cp.status != want {
+		t.Errorf("status = %q, want %q", cp.status, want)
+	}
+
+	// Moving on clears the status
+	cp.Next()
+	if cp.status != "" {
+		t.Errorf("status = %q after navigating, want empty", cp.status)
+	}
+}
+
+func TestCommandPickerEmpty(t *testing.T) {
+	cp := NewCommandPicker(nil)
+	cp.Next()
+	cp.Prev()
+	cp.Copy()
+
+	if got := cp.Selected(); got != "" {
+		t.Errorf("Selected() = %q, want empty", got)
+	}
+	if cp.status != "" {
+		t.Errorf("status = %q, want empty", cp.status)
+	}
+}
+
+func TestCommandPickerKeys(t *testing.T) {
+	m := newTestAppModel(t)
+	m.commandPicker = NewCommandPicker([]string{"cd TestApp", "npm run dev"})
+
+	m.handleCommandPickerKey("down")
+	if got := m.commandPicker.Selected(); got != "npm run dev" {
+		t.Errorf("after down: Selected() = %q, want npm run dev", got)
+	}
+	m.handleCommandPickerKey("k")
+	if got := m.commandPicker.Selected(); got != "cd TestApp" {
+		t.Errorf("after k: Selected() = %q, want cd TestApp", got)
+	}
+	if cmd := m.handleCommandPickerKey("q"); cmd == nil {
+		t.Error("q did not quit")
+	}
+}
@@ -0,0 +1,163 @@
+package models
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+)
+
+// CompletionAction is an entry in the post-completion menu
+type CompletionAction int
+
+const (
+	CompletionViewAAR CompletionAction = iota
+	CompletionSaveReport
+	CompletionRerun
+	CompletionCopyDevCommand
+	CompletionQuit
+)
+
+// String returns the menu label for the action
+func (a CompletionAction) String() string {
+	switch a {
+	case CompletionViewAAR:
+		return "View full AAR"
+	case CompletionSaveReport:
+		return "Save report"
+	case CompletionRerun:
+		return "Re-run"
+	case CompletionCopyDevCommand:
+		return "Copy dev command"
+	case CompletionQuit:
+		return "Quit"
+	default:
+		return "Unknown"
+	}
+}
+
+// completionReportPath is where "Save report" writes the plain-text AAR
+var completionReportPath = filepath.Join(".engx", "last-aar.txt")
+
+// CompletionMenu is the navigable menu shown after a successful run with
+// --interactive-complete
+type CompletionMenu struct {
+	actions    []CompletionAction
+	selected   int
+	status     string
+	showAAR    bool
+	aarOutput  string
+	devCommand string
+}
+
+// NewCompletionMenu creates a menu over the finished run's AAR and dev command
+func NewCompletionMenu(aarOutput, devCommand string) *CompletionMenu {
+	return &CompletionMenu{
+		actions: []CompletionAction{
+			CompletionViewAAR,
+			CompletionSaveReport,
+			CompletionRerun,
+			CompletionCopyDevCommand,
+			CompletionQuit,
+		},
+		aarOutput:  aarOutput,
+		devCommand: devCommand,
+	}
+}
+
+// Actions returns the menu entries in display order
+func (cm *CompletionMenu) Actions() []CompletionAction {
+	return cm.actions
+}
+
+// Next moves the highlight down, wrapping at the end
+func (cm *CompletionMenu) Next() {
+	cm.selected = (cm.selected + 1) % len(cm.actions)
+}
+
+// Prev moves the highlight up, wrapping at the start
+func (cm *CompletionMenu) Prev() {
+	cm.selected = (cm.selected - 1 + len(cm.actions)) % len(cm.actions)
+}
+
+// Selected returns the highlighted action
+func (cm *CompletionMenu) Selected() CompletionAction {
+	return cm.actions[cm.selected]
+}
+
+// ToggleAAR shows or hides the full AAR above the menu
+func (cm *CompletionMenu) ToggleAAR() {
+	cm.showAAR = !cm.showAAR
+	cm.status = ""
+}
+
+// SaveReport writes the AAR without colors to .engx/last-aar.txt
+func (cm *CompletionMenu) SaveReport() {
+	if err := os.MkdirAll(filepath.Dir(completionReportPath), 0755); err != nil {
+		cm.status = fmt.Sprintf("Save failed: %v", err)
+		return
+	}
+	if err := os.WriteFile(completionReportPath, []byte(styles.StripANSI(cm.aarOutput)), 0644); err != nil {
+		cm.status = fmt.Sprintf("Save failed: %v", err)
+		return
+	}
+	cm.status = fmt.Sprintf("Saved report to %s", completionReportPath)
+}
+
+// CopyDevCommand copies the dev server command to the clipboard, falling
+// back to showing it when no clipboard is available
+func (cm *CompletionMenu) CopyDevCommand() {
+	if clipboard.Unsupported {
+		cm.status = fmt.Sprintf("No clipboard available - copy manually: %s", cm.devCommand)
+		return
+	}
+	if err := clipboard.WriteAll(cm.devCommand); err != nil {
+		cm.status = fmt.Sprintf("Copy failed (%v) - copy manually: %s", err, cm.devCommand)
+		return
+	}
+	cm.status = fmt.Sprintf("Copied: %s", cm.devCommand)
+}
+
+// View renders the menu, preceded by the AAR when it has been toggled on
+func (cm *CompletionMenu) View() string {
+	var output strings.Builder
+
+	if cm.showAAR {
+		output.WriteString(cm.aarOutput)
+		output.WriteString("\n")
+	}
+
+	output.WriteString(styles.HeaderStyle.Render("Setup complete - what next?"))
+	output.WriteString("\n")
+	for i, action := range cm.actions {
+		if i == cm.selected {
+			output.WriteString(styles.InfoStyle.Render("❯ " + action.String()))
+		} else {
+			output.WriteString(styles.MutedStyle.Render("  " + action.String()))
+		}
+		output.WriteString("\n")
+	}
+
+	if cm.status != "" {
+		output.WriteString("\n")
+		output.WriteString(styles.SuccessStyle.Render(cm.status))
+		output.WriteString("\n")
+	}
+
+	output.WriteString("\n")
+	output.WriteString(styles.MutedStyle.Render("[↑↓] Navigate • [Enter] Select • [q] Quit"))
+	return output.String()
+}
+
+// devCommandFrom picks the dev server command out of the AAR's suggestions
+func devCommandFrom(commands []string, target string) string {
+	for _, command := range commands {
+		if strings.Contains(command, "npm run dev") {
+			return command
+		}
+	}
+	return fmt.Sprintf("cd ./%s && npm run dev", target)
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/atotto/clipboard"
+	"github.com/bthompso/engx-ergonomics-poc/internal/chaos"
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	"github.com/bthompso/engx-ergonomics-poc/internal/prompts"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCompletionMenuOptions(t *testing.T) {
+	cm := NewCompletionMenu("AAR", "cd ./TestApp && npm run dev")
+
+	var labels []string
+	for _, action := range cm.Actions() {
+		labels = append(labels, action.String())
+	}
+	want := []string{"View full AAR", "Save report", "Re-run", "Copy dev command", "Quit"}
+	if !reflect.DeepEqual(labels, want) {
+		t.Errorf("menu options = %q, want %q", labels, want)
+	}
+
+	if cm.Selected() != CompletionViewAAR {
+		t.Errorf("initial selection = %s, want %s", cm.Selected(), CompletionViewAAR)
+	}
+	cm.Prev()
+	if cm.Selected() != CompletionQuit {
+		t.Errorf("Prev() from the top = %s, want it to wrap to %s", cm.Selected(), CompletionQuit)
+	}
+	cm.Next()
+	if cm.Selected() != CompletionViewAAR {
+		t.Errorf("Next() from the bottom = %s, want it to wrap to %s", cm.Selected(), CompletionViewAAR)
+	}
+}
+
+// newCompletedMenuModel finishes a headless run and opens the completion menu
+// the way the TUI does once the AAR is displayed
+func newCompletedMenuModel(t *testing.T) *AppModel {
+	t.Helper()
+
+	m := newTestAppModel(t)
+	m.SetInteractiveComplete(true)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	m.Update(DisplayAARMsg{AAR: m.GetAARSummary(), Output: "FULL AAR OUTPUT"})
+	if m.completionMenu == nil {
+		t.Fatal("DisplayAARMsg did not open the completion menu")
+	}
+	return m
+}
+
+// pressKeys sends keys to the model and returns the last command
+func pressKeys(m *AppModel, keys ...tea.KeyType) tea.Cmd {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		_, cmd = m.Update(tea.KeyMsg{Type: key})
+	}
+	return cmd
+}
+
+func TestCompletionMenuDispatch(t *testing.T) {
+	previous := clipboard.Unsupported
+	clipboard.Unsupported = true
+	t.Cleanup(func() { clipboard.Unsupported = previous })
+
+	reportPath := filepath.Join(t.TempDir(), ".engx", "last-aar.txt")
+	previousPath := completionReportPath
+	completionReportPath = reportPath
+	t.Cleanup(func() { completionReportPath = previousPath })
+
+	m := newCompletedMenuModel(t)
+
+	pressKeys(m, tea.KeyEnter)
+	if !strings.Contains(m.View(), "FULL AAR OUTPUT") {
+		t.Error("View full AAR did not show the AAR above the menu")
+	}
+
+	pressKeys(m, tea.KeyDown, tea.KeyEnter)
+	if data, err := os.ReadFile(reportPath); err != nil || string(data) != "FULL AAR OUTPUT" {
+		t.Errorf("Save report wrote %q (err %v), want the plain AAR", data, err)
+	}
+
+	pressKeys(m, tea.KeyDown, tea.KeyDown, tea.KeyEnter)
+	if !strings.Contains(m.completionMenu.status, "npm run dev") {
+		t.Errorf("Copy dev command status = %q, want the dev command shown", m.completionMenu.status)
+	}
+
+	cmd := pressKeys(m, tea.KeyDown, tea.KeyEnter)
+	if cmd == nil {
+		t.Fatal("Quit returned no command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Quit did not quit the program")
+	}
+}
+
+func TestCompletionMenuRerunStartsFresh(t *testing.T) {
+	m := newCompletedMenuModel(t)
+
+	if cmd := pressKeys(m, tea.KeyDown, tea.KeyDown, tea.KeyEnter); cmd == nil {
+		t.Fatal("Re-run returned no command to start execution")
+	}
+	if m.completionMenu != nil {
+		t.Error("Re-run left the completion menu open")
+	}
+	if m.completed || m.aarOutput != "" {
+		t.Error("Re-run kept the previous run's completion state")
+	}
+}
+
+func TestRerunStartsWithFullInjectionBudget(t *testing.T) {
+	t.Setenv("CHAOS_MARINE_FORCE_INJECTION", "true")
+
+	chaosConfig := chaos.NewDefaultConfig()
+	chaosConfig.Enabled = true
+	chaosConfig.RandomSeed = 1
+	chaosConfig.MaxCPUUsagePercent = 50
+	chaosConfig.MaxInjectionCount = 2
+	chaosConfig.FailureChaining = true
+	chaosConfig.CascadePrevent = false
+	injector, err := chaos.NewSafeChaosInjector(chaosConfig)
+	if err != nil {
+		t.Fatalf("NewSafeChaosInjector() error = %v", err)
+	}
+
+	userConfig := prompts.DefaultUserConfiguration()
+	userConfig.ProjectName = "TestApp"
+	m := NewAppModelWithChaos("create", "TestApp", nil, userConfig, config.NewVerbosityConfig(config.VerbosityDefault), injector)
+	m.SetColorEnabled(false)
+	m.SetSeed(testSeed)
+	m.SetKeepGoing(true)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+
+	stepName := m.tracker.GetSteps()[0].Name
+	if inject, _ := injector.ShouldInjectTraced(stepName); inject {
+		t.Fatal("injection budget is not used up after the first run; the test cannot tell a reset apart")
+	}
+
+	m.rerun()
+
+	if inject, reason := injector.ShouldInjectTraced(stepName); !inject {
+		t.Errorf("ShouldInjectTraced() after re-run = false (%s), want a full injection budget", reason)
+	}
+	if pending := injector.PendingChainedScenarios(); len(pending) != 0 {
+		t.Errorf("PendingChainedScenarios() after re-run = %v, want none carried over", pending)
+	}
+	if history := injector.GetOperationHistory(); len(history) != 0 {
+		t.Errorf("re-run starts with %d injection events from the last run, want none", len(history))
+	}
+}
+
+func TestCompletionMenuSkippedAfterFailedSteps(t *testing.T) {
+	m := newTestAppModel(t)
+	m.SetInteractiveComplete(true)
+	if _, err := m.RunHeadless(); err != nil {
+		t.Fatalf("RunHeadless() error = %v", err)
+	}
+	m.failedSteps = 1
+
+	m.Update(DisplayAARMsg{AAR: m.GetAARSummary(), Output: "FULL AAR OUTPUT"})
+	if m.completionMenu != nil {
+		t.Error("completion menu opened after a run with failed steps")
+	}
+}
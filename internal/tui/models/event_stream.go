@@ -0,0 +1,179 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+)
+
+// OutputMode selects how engx create reports progress
+type OutputMode int
+
+const (
+	OutputText OutputMode = iota // Interactive TUI progress table
+	OutputJSON                   // Newline-delimited JSON events
+)
+
+// String returns the flag value for the output mode
+func (o OutputMode) String() string {
+	switch o {
+	case OutputText:
+		return "text"
+	case OutputJSON:
+		return "json"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseOutputMode parses an --output value
+func ParseOutputMode(s string) (OutputMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "text", "":
+		return OutputText, nil
+	case "json":
+		return OutputJSON, nil
+	default:
+		return OutputText, fmt.Errorf("invalid output: %s (expected text, json)", s)
+	}
+}
+
+// Event types written by --output=json
+const (
+	EventStep    = "step"
+	EventSummary = "summary"
+)
+
+// Step statuses reported in a StepEvent
+const (
+	StepEventRunning  = "running"
+	StepEventComplete = "complete"
+	StepEventFailed   = "failed"
+)
+
+// StepEvent is written on every step transition:
+//
+//	{"event":"step","step_index":0,"name":"Validating project name","status":"running","progress":0,"elapsed_ms":0,"delta_ms":0,"delta_progress":0}
+//
+// status is running when the step starts and complete or failed when it ends.
+// progress is the step's own progress (0 or 1) and elapsed_ms is the time
+// since the run started. delta_ms and delta_progress are the time and overall
+// run progress since the previous event; neither is ever negative, and the
+// delta_progress values sum to the fraction of steps finished (1 for a full run).
+type StepEvent struct {
+	Event         string  `json:"event"`
+	StepIndex     int     `json:"step_index"`
+	Name          string  `json:"name"`
+	Status        string  `json:"status"`
+	Progress      float64 `json:"progress"`
+	ElapsedMs     int64   `json:"elapsed_ms"`
+	DeltaMs       int64   `json:"delta_ms"`
+	DeltaProgress float64 `json:"delta_progress"`
+}
+
+// SummaryEvent is always the last line of the stream and carries the full AAR:
+//
+//	{"event":"summary","summary":{"project_info":{...},"execution_info":{...},...}}
+type SummaryEvent struct {
+	Event   string          `json:"event"`
+	Summary *aar.AARSummary `json:"summary"`
+}
+
+// RunJSONEvents runs the steps in real time without the TUI, writing a
+// StepEvent to w for each step transition and a final SummaryEvent
+func (m *AppModel) RunJSONEvents(w io.Writer, interval time.Duration) error {
+	if m.tracker == nil {
+		return fmt.Errorf("no tracker available for command %q", m.command)
+	}
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	encoder := json.NewEncoder(w)
+	emit := func(event interface{}) error {
+		if err := encoder.Encode(event); err != nil {
+			return fmt.Errorf("failed to write event: %w", err)
+		}
+		return nil
+	}
+
+	m.tracker.Start()
+	m.state = StateExecuting
+	started := time.Now()
+	var lastElapsed int64
+	var lastOverall float64
+	totalSteps := float64(m.tracker.TotalSteps())
+	stepEvent := func(index int, name, status string, progress float64) StepEvent {
+		elapsed := time.Since(started).Milliseconds()
+		delta := elapsed - lastElapsed
+		lastElapsed = elapsed
+
+		// Overall progress counts finished steps, so a step that ends, failed
+		// or not, moves the run forward by one step's share
+		finished := float64(index)
+		if status != StepEventRunning {
+			finished++
+		}
+		overall := finished / totalSteps
+		deltaProgress := overall - lastOverall
+		lastOverall = overall
+
+		return StepEvent{
+			Event:         EventStep,
+			StepIndex:     index,
+			Name:          name,
+			Status:        status,
+			Progress:      progress,
+			ElapsedMs:     elapsed,
+			DeltaMs:       delta,
+			DeltaProgress: deltaProgress,
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for !m.tracker.IsCompleted() {
+		stepIndex := m.tracker.CurrentStep()
+		stepInfo := m.tracker.CurrentStepInfo()
+		if stepInfo == nil {
+			break
+		}
+		if err := emit(stepEvent(stepIndex, stepInfo.Name, StepEventRunning, 0)); err != nil {
+			return err
+		}
+
+		for !m.tracker.IsStepReady() {
+			<-ticker.C
+		}
+
+		failedBefore := m.failedSteps
+		more := m.completeStepHeadless()
+		status, progress := StepEventComplete, 1.0
+		if m.failedSteps > failedBefore || m.state == StateError {
+			status, progress = StepEventFailed, 0
+		}
+		if err := emit(stepEvent(stepIndex, stepInfo.Name, status, progress)); err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	summary, err := m.finishHeadless()
+	if err != nil {
+		return err
+	}
+	if err := emit(SummaryEvent{Event: EventSummary, Summary: summary}); err != nil {
+		return err
+	}
+	if m.state == StateError {
+		return m.error
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package models
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/aar"
+	progresssim "github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+)
+
+// decodeEvents splits an --output=json stream into its step events and the
+// final summary event, failing if the summary is missing or not last
+func decodeEvents(t *testing.T, output []byte) ([]StepEvent, SummaryEvent) {
+	t.Helper()
+
+	var steps []StepEvent
+	var summary SummaryEvent
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		var header struct {
+			Event string `json:"event"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		if summary.Event != "" {
+			t.Fatalf("event %q follows the summary event", header.Event)
+		}
+
+		switch header.Event {
+		case EventStep:
+			var event StepEvent
+			if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+				t.Fatalf("step event %q: %v", scanner.Text(), err)
+			}
+			steps = append(steps, event)
+		case EventSummary:
+			if err := json.Unmarshal(scanner.Bytes(), &summary); err != nil {
+				t.Fatalf("summary event: %v", err)
+			}
+		default:
+			t.Fatalf("unknown event type %q", header.Event)
+		}
+	}
+	if summary.Event == "" || summary.Summary == nil {
+		t.Fatal("stream has no final summary event")
+	}
+	return steps, summary
+}
+
+func TestJSONEventsReportEveryStepTransition(t *testing.T) {
+	m := newFastAppModel(t, time.Millisecond)
+
+	var output bytes.Buffer
+	if err := m.RunJSONEvents(&output, time.Millisecond); err != nil {
+		t.Fatalf("RunJSONEvents() error = %v", err)
+	}
+
+	events, summary := decodeEvents(t, output.Bytes())
+	steps := m.tracker.GetSteps()
+	if len(events) != 2*len(steps) {
+		t.Fatalf("stream has %d step events, want running and complete for each of %d steps", len(events), len(steps))
+	}
+
+	var lastElapsed int64
+	for i, step := range steps {
+		running, complete := events[2*i], events[2*i+1]
+		if running.StepIndex != i || running.Name != step.Name || running.Status != StepEventRunning || running.Progress != 0 {
+			t.Errorf("event %d = %+v, want step %d (%s) running at 0", 2*i, running, i, step.Name)
+		}
+		if complete.StepIndex != i || complete.Name != step.Name || complete.Status != StepEventComplete || complete.Progress != 1 {
+			t.Errorf("event %d = %+v, want step %d (%s) complete at 1", 2*i+1, complete, i, step.Name)
+		}
+		for _, event := range []StepEvent{running, complete} {
+			if event.ElapsedMs < lastElapsed {
+				t.Errorf("elapsed_ms went backwards at step %d: %d -> %d", i, lastElapsed, event.ElapsedMs)
+			}
+			lastElapsed = event.ElapsedMs
+		}
+	}
+
+	if summary.Summary.ProjectInfo.Name != "TestApp" || len(summary.Summary.StepResults) != len(steps) {
+		t.Errorf("summary = %+v, want the TestApp AAR with %d step results", summary.Summary.ProjectInfo, len(steps))
+	}
+}
+
+func TestJSONEventsReportFailedStep(t *testing.T) {
+	errScaffold := errors.New("scaffold: permission denied")
+	m := newFastAppModel(t, time.Millisecond)
+	m.tracker.GetStep(1).Executor = progresssim.StepExecutorFunc(func(context.Context) error {
+		return errScaffold
+	})
+
+	var output bytes.Buffer
+	if err := m.RunJSONEvents(&output, time.Millisecond); !errors.Is(err, errScaffold) {
+		t.Fatalf("RunJSONEvents() error = %v, want the executor's error", err)
+	}
+
+	events, summary := decodeEvents(t, output.Bytes())
+	last := events[len(events)-1]
+	if last.StepIndex != 1 || last.Status != StepEventFailed || last.Progress != 0 {
+		t.Errorf("last step event = %+v, want step 1 failed", last)
+	}
+	results := summary.Summary.StepResults
+	if len(results) != 2 || results[1].Status != aar.StepStatusFailed {
+		t.Errorf("summary step results = %+v, want the run to stop at the failed second step", results)
+	}
+}
+
+func TestParseOutputMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    OutputMode
+		wantErr bool
+	}{
+		{"", OutputText, false},
+		{"text", OutputText, false},
+		{" JSON ", OutputJSON, false},
+		{"yaml", OutputText, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOutputMode(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseOutputMode(%q) error = %v, wantErr %t", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseOutputMode(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestJSONEventDeltasAreNonNegativeAndSumToProgress(t *testing.T) {
+	m := newFastAppModel(t, 2*time.Millisecond)
+
+	var output bytes.Buffer
+	if err := m.RunJSONEvents(&output, time.Millisecond); err != nil {
+		t.Fatalf("RunJSONEvents() error = %v", err)
+	}
+
+	events, _ := decodeEvents(t, output.Bytes())
+	var sumMs int64
+	var sumProgress float64
+	for i, event := range events {
+		if event.DeltaMs < 0 || event.DeltaProgress < 0 {
+			t.Errorf("event %d has a negative delta: %+v", i, event)
+		}
+		if event.Status == StepEventRunning && event.DeltaProgress != 0 {
+			t.Errorf("running event %d moved overall progress by %v", i, event.DeltaProgress)
+		}
+		sumMs += event.DeltaMs
+		sumProgress += event.DeltaProgress
+	}
+
+	if last := events[len(events)-1]; sumMs != last.ElapsedMs {
+		t.Errorf("delta_ms sums to %d, want the last elapsed_ms %d", sumMs, last.ElapsedMs)
+	}
+	if math.Abs(sumProgress-1) > 1e-9 {
+		t.Errorf("delta_progress sums to %v, want 1 for a full run", sumProgress)
+	}
+}
+
+func TestJSONEventDeltasStopAtFailedStep(t *testing.T) {
+	m := newFastAppModel(t, time.Millisecond)
+	m.tracker.GetStep(1).Executor = progresssim.StepExecutorFunc(func(context.Context) error {
+		return errors.New("scaffold: permission denied")
+	})
+
+	var output bytes.Buffer
+	if err := m.RunJSONEvents(&output, time.Millisecond); err == nil {
+		t.Fatal("RunJSONEvents() succeeded with a failing step")
+	}
+
+	events, _ := decodeEvents(t, output.Bytes())
+	var sumProgress float64
+	for _, event := range events {
+		sumProgress += event.DeltaProgress
+	}
+	if want := 2 / float64(m.tracker.TotalSteps()); math.Abs(sumProgress-want) > 1e-9 {
+		t.Errorf("delta_progress sums to %v, want %v for two finished steps", sumProgress, want)
+	}
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/config"
+	progresssim "github.com/bthompso/engx-ergonomics-poc/internal/simulation/progress"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/components"
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/components/prompts"
+)
+
+// installedAfterRun runs every create step to completion and returns the
+// renderer's final component statuses for the production selections
+func installedAfterRun(production config.ProductionConfig) map[string]string {
+	tracker := progresssim.NewCreateTracker(false)
+	var stepNames []string
+	for _, step := range tracker.GetSteps() {
+		stepNames = append(stepNames, step.Name)
+	}
+
+	renderer := components.NewEnhancedRendererWithColor("TestApp", "./TestApp", "typescript", stepNames, false, false)
+	renderer.SetProductionConfig(production)
+	for _, name := range stepNames {
+		renderer.UpdateComponentStatuses(name, 1.0)
+	}
+	return renderer.ComponentStatuses()
+}
+
+func TestSelectingGRPCInstallsGRPCWeb(t *testing.T) {
+	selector := prompts.NewProductionFeatureSelector()
+	selector.SetValue(config.ProductionConfig{GRPC: true})
+
+	production := selector.GetValue().(config.ProductionConfig)
+	if !production.GRPC {
+		t.Fatal("production selector did not report gRPC Web as selected")
+	}
+
+	statuses := installedAfterRun(production)
+	if got := statuses["gRPC Web"]; got != "installed" {
+		t.Errorf("gRPC Web status = %q, want installed when selected", got)
+	}
+	for _, name := range []string{"TrustBridge SSO", "GRID/HDFS Access"} {
+		if _, ok := statuses[name]; ok {
+			t.Errorf("%s is planned without being selected", name)
+		}
+	}
+
+	if _, ok := installedAfterRun(config.ProductionConfig{})["gRPC Web"]; ok {
+		t.Error("gRPC Web is planned without being selected")
+	}
+}
+
+func TestConfiguredRendererOmitsUnselectedIntegrations(t *testing.T) {
+	m := newTestAppModel(t)
+	m.userConfig.ProductionSetup = config.ProductionConfig{}
+	m.updateComponentsFromConfig()
+
+	statuses := m.renderer.ComponentStatuses()
+	for _, name := range []string{"TrustBridge SSO", "gRPC Web", "GRID/HDFS Access"} {
+		if _, ok := statuses[name]; ok {
+			t.Errorf("dev-only run still lists production integration %q", name)
+		}
+	}
+	if m.tracker.TotalSteps() != len(progresssim.NewCreateTracker(true).GetSteps()) {
+		t.Errorf("dev-only run has %d steps, want the dev-only plan", m.tracker.TotalSteps())
+	}
+}
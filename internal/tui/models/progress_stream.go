@@ -0,0 +1,92 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// DefaultProgressInterval is how often --progress-only samples the tracker
+const DefaultProgressInterval = 100 * time.Millisecond
+
+// ProgressLine is one NDJSON record written by --progress-only. DeltaMs and
+// DeltaOverall are the time and overall progress since the previous line, so
+// consumers can smooth their animation; both are never negative and the
+// DeltaOverall values sum to the final Overall.
+type ProgressLine struct {
+	Overall      float64 `json:"overall"`
+	Step         int     `json:"step"`
+	Name         string  `json:"name"`
+	DeltaMs      int64   `json:"delta_ms"`
+	DeltaOverall float64 `json:"delta_overall"`
+}
+
+// RunProgressOnly runs the steps in real time without the TUI, writing a
+// compact ProgressLine to w at most once per interval whenever progress moves.
+// Overall progress never decreases and the last line is always 1.0.
+func (m *AppModel) RunProgressOnly(w io.Writer, interval time.Duration) error {
+	if m.tracker == nil {
+		return fmt.Errorf("no tracker available for command %q", m.command)
+	}
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+
+	encoder := json.NewEncoder(w)
+	var last ProgressLine
+	lastAt := time.Now()
+	emit := func(line ProgressLine) error {
+		if line.Overall < last.Overall {
+			line.Overall = last.Overall
+		}
+		if line.Overall == last.Overall && line.Step == last.Step && line.Name == last.Name {
+			return nil
+		}
+		now := time.Now()
+		line.DeltaMs = now.Sub(lastAt).Milliseconds()
+		line.DeltaOverall = math.Round((line.Overall-last.Overall)*1000) / 1000
+		last, lastAt = line, now
+		if err := encoder.Encode(line); err != nil {
+			return fmt.Errorf("failed to write progress: %w", err)
+		}
+		return nil
+	}
+
+	m.tracker.Start()
+	m.state = StateExecuting
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for !m.tracker.IsCompleted() {
+		if m.tracker.IsStepReady() && !m.completeStepHeadless() {
+			break
+		}
+
+		if stepInfo := m.tracker.CurrentStepInfo(); stepInfo != nil {
+			line := ProgressLine{
+				Overall: math.Round(m.tracker.Progress()*1000) / 1000,
+				Step:    m.tracker.CurrentStep(),
+				Name:    stepInfo.Name,
+			}
+			if err := emit(line); err != nil {
+				return err
+			}
+		}
+
+		if !m.tracker.IsCompleted() {
+			<-ticker.C
+		}
+	}
+
+	if _, err := m.finishHeadless(); err != nil {
+		return err
+	}
+	if m.state == StateError {
+		return m.error
+	}
+
+	return emit(ProgressLine{Overall: 1.0, Step: m.tracker.TotalSteps(), Name: "Complete"})
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newFastAppModel returns a test model whose steps each take d of real time
+func newFastAppModel(t *testing.T, d time.Duration) *AppModel {
+	t.Helper()
+	m := newTestAppModel(t)
+	for i := 0; i < m.tracker.TotalSteps(); i++ {
+		if err := m.tracker.SetStepDuration(i, d); err != nil {
+			t.Fatalf("SetStepDuration(%d) error = %v", i, err)
+		}
+	}
+	return m
+}
+
+// decodeProgressLines parses every NDJSON line written by RunProgressOnly
+func decodeProgressLines(t *testing.T, output []byte) []ProgressLine {
+	t.Helper()
+	var lines []ProgressLine
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		var line ProgressLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		t.Fatal("RunProgressOnly() wrote no lines")
+	}
+	return lines
+}
+
+func TestProgressOnlyOverallIsMonotonicAndEndsAtOne(t *testing.T) {
+	m := newFastAppModel(t, 5*time.Millisecond)
+
+	var output bytes.Buffer
+	if err := m.RunProgressOnly(&output, time.Millisecond); err != nil {
+		t.Fatalf("RunProgressOnly() error = %v", err)
+	}
+
+	lines := decodeProgressLines(t, output.Bytes())
+	for i := 1; i < len(lines); i++ {
+		if lines[i].Overall < lines[i-1].Overall {
+			t.Errorf("overall went backwards at line %d: %v -> %v", i, lines[i-1].Overall, lines[i].Overall)
+		}
+	}
+
+	last := lines[len(lines)-1]
+	if last.Overall != 1.0 || last.Name != "Complete" {
+		t.Errorf("last line = %+v, want overall 1.0 named Complete", last)
+	}
+	if last.Step != m.tracker.TotalSteps() {
+		t.Errorf("last line step = %d, want %d", last.Step, m.tracker.TotalSteps())
+	}
+}
+
+func TestZeroDurationStepsRenderWithoutNaN(t *testing.T) {
+	m := newFastAppModel(t, 0)
+
+	screen := m.RenderVirtualScreen(DefaultTerminalWidth, 60, 0)
+	for _, bad := range []string{"NaN", "Inf"} {
+		if strings.Contains(screen, bad) {
+			t.Errorf("virtual screen of zero-duration steps contains %q:\n%s", bad, screen)
+		}
+	}
+
+	if _, err := newFastAppModel(t, 0).RunHeadless(); err != nil {
+		t.Errorf("RunHeadless() with zero-duration steps error = %v", err)
+	}
+}
+
+func TestProgressOnlyDeltasAreNonNegativeAndSumToOverall(t *testing.T) {
+	m := newFastAppModel(t, 5*time.Millisecond)
+
+	var output bytes.Buffer
+	if err := m.RunProgressOnly(&output, time.Millisecond); err != nil {
+		t.Fatalf("RunProgressOnly() error = %v", err)
+	}
+
+	lines := decodeProgressLines(t, output.Bytes())
+	var sum float64
+	for i, line := range lines {
+		if line.DeltaMs < 0 || line.DeltaOverall < 0 {
+			t.Errorf("line %d has a negative delta: %+v", i, line)
+		}
+		sum += line.DeltaOverall
+	}
+
+	// Each delta is rounded to 0.001, so allow that much drift per line
+	if tolerance := 0.0005 * float64(len(lines)); math.Abs(sum-1) > tolerance {
+		t.Errorf("delta_overall sums to %v over %d lines, want 1.0", sum, len(lines))
+	}
+}
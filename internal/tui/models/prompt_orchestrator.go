@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/bthompso/engx-ergonomics-poc/internal/config"
 	"github.com/bthompso/engx-ergonomics-poc/internal/tui/components/prompts"
@@ -18,6 +20,9 @@ type PromptOrchestrator struct {
 
 // NewPromptOrchestrator creates a new prompt orchestrator
 func NewPromptOrchestrator(projectName string) PromptOrchestrator {
+	// Org-specific prompt settings (falls back to built-ins without a file)
+	promptConfig, promptConfigErr := config.LoadPromptConfiguration()
+
 	// Start with smart defaults
 	config := config.GetSmartDefaults(projectName)
 
@@ -65,7 +70,7 @@ func NewPromptOrchestrator(projectName string) PromptOrchestrator {
 		},
 	}
 
-	return PromptOrchestrator{
+	orchestrator := PromptOrchestrator{
 		prompts:     promptSteps,
 		currentIndex: 0,
 		config:      &config,
@@ -80,6 +85,36 @@ func NewPromptOrchestrator(projectName string) PromptOrchestrator {
 		completed:   false,
 		projectName: projectName,
 	}
+
+	// Org-specific selection minimums; bad entries keep the built-in minimum,
+	// like the rest of the prompt configuration
+	if promptConfigErr == nil {
+		for promptID, n := range promptConfig.MinSelected {
+			_ = orchestrator.SetMinSelected(promptID, n)
+		}
+	}
+
+	return orchestrator
+}
+
+// SetMinSelected sets the minimum number of selections for a multi-select
+// prompt and marks it required (not skippable) when the minimum is above zero
+func (po *PromptOrchestrator) SetMinSelected(promptID string, n int) error {
+	for i := range po.prompts {
+		if po.prompts[i].ID != promptID {
+			continue
+		}
+		selector, ok := po.prompts[i].Component.(*prompts.FeatureSelector)
+		if !ok {
+			return fmt.Errorf("prompt %q is not a multi-select prompt", promptID)
+		}
+		if err := selector.SetMinSelected(n); err != nil {
+			return err
+		}
+		po.prompts[i].Required = n > 0
+		return nil
+	}
+	return fmt.Errorf("unknown prompt: %s", promptID)
 }
 
 // Init initializes the orchestrator
@@ -111,11 +146,17 @@ func (po *PromptOrchestrator) Update(msg tea.Msg) (PromptOrchestrator, tea.Cmd)
 			if po.navigation.CanSkip && !currentPrompt.Required {
 				return po.skipCurrentPrompt()
 			}
+		case "r":
+			// Reset the current prompt to its recommended defaults
+			currentPrompt.Component.Reset()
+			return *po, nil
 		}
 
 	case prompts.CompletePromptMsg:
 		// Save the current prompt's value
-		po.savePromptValue(currentPrompt)
+		if err := po.savePromptValue(currentPrompt); err != nil {
+			return *po, func() tea.Msg { return ErrorMsg{Error: err} }
+		}
 
 		// Check if we need to add confirmation step
 		if po.currentIndex == len(po.prompts)-1 && currentPrompt.Type != prompts.PromptTypeConfirmation {
@@ -219,7 +260,8 @@ func (po *PromptOrchestrator) goToPreviousPrompt() (PromptOrchestrator, tea.Cmd)
 func (po *PromptOrchestrator) skipCurrentPrompt() (PromptOrchestrator, tea.Cmd) {
 	currentPrompt := &po.prompts[po.currentIndex]
 	if !currentPrompt.Required {
-		// Don't save value when skipping
+		// Don't save value when skipping, but note that defaults were used
+		po.recordSkippedPrompt(currentPrompt.Title)
 		if po.currentIndex < len(po.prompts)-1 {
 			return po.goToNextPrompt()
 		} else {
@@ -237,33 +279,74 @@ func (po *PromptOrchestrator) updateNavigation() {
 	po.navigation.CurrentStep = po.currentIndex + 1
 }
 
-func (po *PromptOrchestrator) savePromptValue(promptStep *prompts.PromptStep) {
+// recordSkippedPrompt notes a skipped prompt in the configuration (once)
+func (po *PromptOrchestrator) recordSkippedPrompt(title string) {
+	for _, skipped := range po.config.SkippedPrompts {
+		if skipped == title {
+			return
+		}
+	}
+	po.config.SkippedPrompts = append(po.config.SkippedPrompts, title)
+}
+
+// clearSkippedPrompt removes a prompt from the skipped list once it is answered
+func (po *PromptOrchestrator) clearSkippedPrompt(title string) {
+	remaining := po.config.SkippedPrompts[:0]
+	for _, skipped := range po.config.SkippedPrompts {
+		if skipped != title {
+			remaining = append(remaining, skipped)
+		}
+	}
+	po.config.SkippedPrompts = remaining
+}
+
+// savePromptValue stores the prompt's value in the configuration. A component
+// whose value doesn't match its prompt type is a bug, so it is reported rather
+// than silently keeping the default.
+func (po *PromptOrchestrator) savePromptValue(promptStep *prompts.PromptStep) error {
 	value := promptStep.Component.GetValue()
+	po.clearSkippedPrompt(promptStep.Title)
 
+	var expected string
 	switch promptStep.Type {
 	case prompts.PromptTypeTemplate:
 		if template, ok := value.(config.TemplateType); ok {
 			po.config.Template.Type = template
+			return nil
 		}
+		expected = "config.TemplateType"
 
 	case prompts.PromptTypeDevFeatures:
 		if devFeatures, ok := value.(config.DevFeatureConfig); ok {
 			po.config.DevFeatures = devFeatures
+			return nil
 		}
+		expected = "config.DevFeatureConfig"
 
 	case prompts.PromptTypeProductionSetup:
 		if prodSetup, ok := value.(config.ProductionConfig); ok {
 			po.config.ProductionSetup = prodSetup
+			return nil
 		}
+		expected = "config.ProductionConfig"
 
 	case prompts.PromptTypeTesting:
 		if testing, ok := value.(config.TestingConfig); ok {
 			po.config.Testing = testing
+			return nil
 		}
+		expected = "config.TestingConfig"
 
 	case prompts.PromptTypeNavigation:
 		if navigation, ok := value.(config.NavigationConfig); ok {
 			po.config.Navigation = navigation
+			return nil
 		}
+		expected = "config.NavigationConfig"
+
+	default:
+		return nil
 	}
+
+	return fmt.Errorf("internal error: prompt %q returned %T, expected %s", promptStep.ID, value, expected)
 }
\ No newline at end of file
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/components/prompts"
+)
+
+// advanceTo moves the orchestrator to the prompt with the given ID
+func advanceTo(t *testing.T, po *PromptOrchestrator, id string) {
+	t.Helper()
+
+	for po.prompts[po.currentIndex].ID != id {
+		if po.currentIndex == len(po.prompts)-1 {
+			t.Fatalf("prompt %q not found", id)
+		}
+		*po, _ = po.goToNextPrompt()
+	}
+}
+
+func TestSkippedProductionPromptIsRecorded(t *testing.T) {
+	po := NewPromptOrchestrator("TestApp")
+	advanceTo(t, &po, "production-setup")
+
+	po, _ = po.Update(prompts.SkipPromptMsg{})
+
+	if got := po.GetConfiguration().SkippedPrompts; !reflect.DeepEqual(got, []string{"Production Setup"}) {
+		t.Fatalf("SkippedPrompts = %v, want [Production Setup]", got)
+	}
+	if id := po.prompts[po.currentIndex].ID; id != "testing" {
+		t.Errorf("current prompt after skip = %q, want testing", id)
+	}
+
+	// Answering the prompt after going back clears the skip
+	po, _ = po.Update(prompts.PrevPromptMsg{})
+	po, _ = po.Update(prompts.CompletePromptMsg{})
+	if got := po.GetConfiguration().SkippedPrompts; len(got) != 0 {
+		t.Errorf("SkippedPrompts = %v after answering, want none", got)
+	}
+}
+
+func TestRequiredPromptCannotBeSkipped(t *testing.T) {
+	po := NewPromptOrchestrator("TestApp")
+
+	po, _ = po.Update(prompts.SkipPromptMsg{})
+
+	if got := po.GetConfiguration().SkippedPrompts; len(got) != 0 {
+		t.Errorf("SkippedPrompts = %v, want none for the required template prompt", got)
+	}
+	if id := po.prompts[po.currentIndex].ID; id != "template" {
+		t.Errorf("current prompt = %q, want template", id)
+	}
+}
+
+// wrongValueComponent is a buggy prompt component whose value has the wrong type
+type wrongValueComponent struct {
+	prompts.PromptComponent
+}
+
+func (wrongValueComponent) GetValue() interface{} { return "docker,ci" }
+
+func TestMismatchedPromptValueIsAnError(t *testing.T) {
+	po := NewPromptOrchestrator("TestApp")
+	advanceTo(t, &po, "production-setup")
+	before := po.GetConfiguration().ProductionSetup
+
+	current := &po.prompts[po.currentIndex]
+	current.Component = wrongValueComponent{current.Component}
+
+	po, cmd := po.Update(prompts.CompletePromptMsg{})
+	if cmd == nil {
+		t.Fatal("Update() returned no command for a mismatched prompt value")
+	}
+	msg, ok := cmd().(ErrorMsg)
+	if !ok {
+		t.Fatalf("command produced %T, want ErrorMsg", cmd())
+	}
+	for _, want := range []string{`"production-setup"`, "string", "config.ProductionConfig"} {
+		if !strings.Contains(msg.Error.Error(), want) {
+			t.Errorf("error %q does not mention %s", msg.Error, want)
+		}
+	}
+
+	if got := po.GetConfiguration().ProductionSetup; got != before {
+		t.Errorf("ProductionSetup = %+v after a mismatched value, want it unchanged", got)
+	}
+	if id := po.prompts[po.currentIndex].ID; id != "production-setup" {
+		t.Errorf("current prompt = %q, want the orchestrator to stay on production-setup", id)
+	}
+}
+
+func TestOrchestratorSetMinSelected(t *testing.T) {
+	po := NewPromptOrchestrator("TestApp")
+	if err := po.SetMinSelected("dev-features", 1); err != nil {
+		t.Fatalf("SetMinSelected(dev-features, 1) error = %v", err)
+	}
+
+	for _, step := range po.prompts {
+		if step.ID != "dev-features" {
+			continue
+		}
+		if !step.Required {
+			t.Error("dev-features with a minimum of 1 is still skippable")
+		}
+		if got := step.Component.(*prompts.FeatureSelector).MinSelected(); got != 1 {
+			t.Errorf("dev-features MinSelected() = %d, want 1", got)
+		}
+	}
+
+	if err := po.SetMinSelected("template", 1); err == nil {
+		t.Error("SetMinSelected() accepted the single-select template prompt")
+	}
+	if err := po.SetMinSelected("no-such-prompt", 1); err == nil {
+		t.Error("SetMinSelected() accepted an unknown prompt")
+	}
+}
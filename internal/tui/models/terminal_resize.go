@@ -0,0 +1,20 @@
+//go:build !windows
+
+package models
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// notifyResize delivers SIGWINCH to ch and reports whether resize signals exist
+func notifyResize(ch chan os.Signal) bool {
+	signal.Notify(ch, syscall.SIGWINCH)
+	return true
+}
+
+// stopResizeNotify stops delivering SIGWINCH to ch
+func stopResizeNotify(ch chan os.Signal) {
+	signal.Stop(ch)
+}
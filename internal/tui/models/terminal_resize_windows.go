@@ -0,0 +1,12 @@
+package models
+
+import "os"
+
+// notifyResize reports that Windows has no resize signal; Bubble Tea polls
+// the console size there instead
+func notifyResize(ch chan os.Signal) bool {
+	return false
+}
+
+// stopResizeNotify has nothing to stop on Windows
+func stopResizeNotify(ch chan os.Signal) {}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"golang.org/x/term"
+)
+
+// Fallback size when stdout is not a terminal (pipes, CI logs)
+const (
+	DefaultTerminalWidth  = 89 // Match the progress template width
+	DefaultTerminalHeight = 24
+)
+
+// resizeDebounce is how long the size must stay unchanged before it is
+// applied, so a window drag relayouts once instead of per message
+const resizeDebounce = 50 * time.Millisecond
+
+// resizeSettledMsg fires resizeDebounce after a size report; only the one
+// matching the latest report is applied
+type resizeSettledMsg struct {
+	seq int
+}
+
+// terminalResizeMsg carries a size re-detected after SIGWINCH
+type terminalResizeMsg struct {
+	width  int
+	height int
+}
+
+// terminalOutput is the file whose size seeds the layout; tests swap in a
+// file that is not a terminal
+var terminalOutput = os.Stdout
+
+// detectTerminalSize queries stdout's size, falling back to the defaults
+// when it is not a terminal or reports no size
+func detectTerminalSize() (int, int) {
+	width, height, err := term.GetSize(int(terminalOutput.Fd()))
+	if err != nil || width <= 0 {
+		return DefaultTerminalWidth, DefaultTerminalHeight
+	}
+	if height <= 0 {
+		height = DefaultTerminalHeight
+	}
+	return width, height
+}
+
+// watchTerminalResize waits for the next SIGWINCH and re-detects the size,
+// as a backup for terminals where Bubble Tea's WindowSizeMsg never arrives
+func (m *AppModel) watchTerminalResize() tea.Cmd {
+	if m.resizeStopped {
+		return nil
+	}
+	if m.resizeSignals == nil {
+		signals := make(chan os.Signal, 1)
+		if !notifyResize(signals) {
+			m.resizeStopped = true
+			return nil
+		}
+		m.resizeSignals = signals
+		m.resizeDone = make(chan struct{})
+	}
+	signals, done := m.resizeSignals, m.resizeDone
+	return func() tea.Msg {
+		select {
+		case <-signals:
+		case <-done:
+			return nil
+		}
+		width, height := detectTerminalSize()
+		return terminalResizeMsg{width: width, height: height}
+	}
+}
+
+// StopResizeWatch turns off the SIGWINCH fallback and releases any pending
+// watch. Call it once the program has quit; a WindowSizeMsg also calls it.
+func (m *AppModel) StopResizeWatch() {
+	if m.resizeStopped {
+		return
+	}
+	m.resizeStopped = true
+	if m.resizeSignals != nil {
+		stopResizeNotify(m.resizeSignals)
+		close(m.resizeDone)
+	}
+}
+
+// queueResize records the latest reported size and schedules it to be applied
+// once no newer size arrives within resizeDebounce
+func (m *AppModel) queueResize(width, height int) tea.Cmd {
+	m.pendingWidth = width
+	m.pendingHeight = height
+	m.resizeSeq++
+	seq := m.resizeSeq
+	return tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+		return resizeSettledMsg{seq: seq}
+	})
+}
+
+// applyResize applies the pending size if no newer report superseded seq,
+// and refreshes the renderer's width-dependent caches
+func (m *AppModel) applyResize(seq int) {
+	if seq != m.resizeSeq {
+		return
+	}
+	m.width = m.layoutWidth(m.pendingWidth)
+	m.height = m.pendingHeight
+	if m.renderer != nil {
+		m.renderer.Resize(m.width)
+	}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bthompso/engx-ergonomics-poc/internal/tui/styles"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNonTerminalOutputUsesDefaultSize(t *testing.T) {
+	file, err := os.Create(filepath.Join(t.TempDir(), "stdout"))
+	if err != nil {
+		t.Fatalf("creating fake stdout: %v", err)
+	}
+	defer file.Close()
+
+	previous := terminalOutput
+	terminalOutput = file
+	t.Cleanup(func() { terminalOutput = previous })
+
+	m := newTestAppModel(t)
+	if m.width != DefaultTerminalWidth || m.height != DefaultTerminalHeight {
+		t.Errorf("size with a non-terminal stdout = %dx%d, want %dx%d",
+			m.width, m.height, DefaultTerminalWidth, DefaultTerminalHeight)
+	}
+	if view := m.View(); view == "Loading..." {
+		t.Error("View() is still waiting for a WindowSizeMsg")
+	}
+}
+
+func TestStopResizeWatchReleasesPendingWatch(t *testing.T) {
+	m := newTestAppModel(t)
+	cmd := m.watchTerminalResize()
+	if cmd == nil {
+		t.Skip("no resize signal on this platform")
+	}
+
+	result := make(chan tea.Msg, 1)
+	go func() { result <- cmd() }()
+
+	m.StopResizeWatch()
+	select {
+	case msg := <-result:
+		if msg != nil {
+			t.Errorf("stopped watch returned %#v, want nil", msg)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("StopResizeWatch() left the pending watch blocked")
+	}
+
+	if m.watchTerminalResize() != nil {
+		t.Error("watchTerminalResize() re-armed after StopResizeWatch()")
+	}
+	m.StopResizeWatch() // Safe to call again
+}
+
+func TestWindowSizeMsgStopsResizeFallback(t *testing.T) {
+	m := newTestAppModel(t)
+	if m.watchTerminalResize() == nil {
+		t.Skip("no resize signal on this platform")
+	}
+	t.Cleanup(m.StopResizeWatch)
+
+	// A SIGWINCH before Bubble Tea's first report is queued once, not per duplicate
+	m.Update(terminalResizeMsg{width: 100, height: 30})
+	m.Update(terminalResizeMsg{width: 100, height: 30})
+	if m.resizeSeq != 1 {
+		t.Errorf("duplicate terminal resizes queued %d times, want 1", m.resizeSeq)
+	}
+
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if !m.resizeStopped {
+		t.Fatal("WindowSizeMsg left the SIGWINCH fallback running")
+	}
+
+	seq := m.resizeSeq
+	if _, cmd := m.Update(terminalResizeMsg{width: 80, height: 24}); cmd != nil || m.resizeSeq != seq {
+		t.Error("a fallback resize was still applied after Bubble Tea took over")
+	}
+}
+
+func TestResizeBurstAppliesOnlyFinalSize(t *testing.T) {
+	m := newTestAppModel(t)
+	startWidth := m.width
+
+	var ticks []tea.Cmd
+	for width := 100; width <= 150; width += 10 {
+		_, cmd := m.Update(tea.WindowSizeMsg{Width: width, Height: width / 4})
+		ticks = append(ticks, cmd)
+		if m.width != startWidth {
+			t.Fatalf("width changed to %d mid-burst, want it held until the resize settles", m.width)
+		}
+	}
+
+	// Every debounce timer fires; only the one for the last report applies
+	settled := make([]tea.Msg, len(ticks))
+	done := make(chan struct{})
+	for i, tick := range ticks {
+		go func(i int, tick tea.Cmd) {
+			settled[i] = tick()
+			done <- struct{}{}
+		}(i, tick)
+	}
+	for range ticks {
+		<-done
+	}
+
+	for i, msg := range settled[:len(settled)-1] {
+		m.Update(msg)
+		if m.width != startWidth {
+			t.Errorf("superseded resize %d applied width %d", i, m.width)
+		}
+	}
+	m.Update(settled[len(settled)-1])
+	if m.width != 150 || m.height != 37 {
+		t.Errorf("size after the burst = %dx%d, want the final 150x37", m.width, m.height)
+	}
+	if sep := styles.StripANSI(m.renderer.Render(m.width)); !strings.Contains(sep, strings.Repeat("-", 150)) {
+		t.Error("renderer was not resized to the final width")
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// virtualScreenEpoch anchors the fixed clock so snapshots never depend on wall time
+var virtualScreenEpoch = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// RenderVirtualScreen renders the live view as it would look after elapsed time
+// on a width x height screen, without starting a bubbletea program. Steps are
+// advanced on a fixed clock so the same inputs always produce the same frame.
+//
+// It is a test helper: it replaces the tracker and renderer clocks and restarts
+// the tracker, so call it once on a freshly built model, never a running one.
+func (m *AppModel) RenderVirtualScreen(width, height int, elapsed time.Duration) string {
+	if m.tracker == nil || m.renderer == nil {
+		return fitToScreen("", height)
+	}
+
+	now := virtualScreenEpoch
+	clock := func() time.Time { return now }
+	m.tracker.SetClock(clock)
+	m.renderer.SetClock(clock)
+	m.tracker.Start()
+
+	m.width = width
+	m.height = height
+	m.state = StateExecuting
+
+	// Complete every step that fits in the elapsed time
+	remaining := elapsed
+	for !m.tracker.IsCompleted() {
+		stepIndex := m.tracker.CurrentStep()
+		stepInfo := m.tracker.CurrentStepInfo()
+		if stepInfo == nil || remaining < stepInfo.Duration {
+			break
+		}
+
+		now = now.Add(stepInfo.Duration)
+		remaining -= stepInfo.Duration
+		m.renderer.UpdateComponentStatuses(stepInfo.Name, 1.0)
+		m.renderer.CompleteStep(stepIndex, stepInfo.Duration)
+		m.tracker.NextStep()
+	}
+
+	if m.tracker.IsCompleted() {
+		m.state = StateComplete
+		m.completed = true
+	} else if stepInfo := m.tracker.CurrentStepInfo(); stepInfo != nil {
+		// Show the running step part-way through
+		stepIndex := m.tracker.CurrentStep()
+		now = now.Add(remaining)
+		stepProgress := stepInfo.ProgressAt(remaining)
+
+		m.currentStep = stepIndex
+		m.stepName = stepInfo.Name
+		m.renderer.SetCurrentStep(stepIndex)
+		m.renderer.UpdateStep(stepIndex, stepProgress, stepInfo.MessageAt(stepProgress), m.getSubSteps(stepInfo.Name))
+		m.renderer.UpdateComponentStatuses(stepInfo.Name, stepProgress)
+	}
+
+	return fitToScreen(m.View(), height)
+}
+
+// fitToScreen clips or pads output to exactly height lines
+func fitToScreen(output string, height int) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if height <= 0 {
+		return strings.Join(lines, "\n")
+	}
+
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+
+	return strings.Join(lines, "\n")
+}
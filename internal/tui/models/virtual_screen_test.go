@@ -0,0 +1,66 @@
+package models
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// assertGolden compares output with testdata/name, rewriting it under -update
+func assertGolden(t *testing.T, name, output string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if output != string(want) {
+		t.Errorf("%s mismatch (run with -update to accept):\n got:\n%s\nwant:\n%s", name, output, want)
+	}
+}
+
+func TestRenderVirtualScreenSnapshots(t *testing.T) {
+	tests := []struct {
+		golden   string
+		fraction float64
+	}{
+		{"virtual_screen_0.golden", 0},
+		{"virtual_screen_50.golden", 0.5},
+		{"virtual_screen_100.golden", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			m := newTestAppModel(t)
+			elapsed := time.Duration(float64(m.tracker.TotalEstimatedDuration()) * tt.fraction)
+
+			screen := m.RenderVirtualScreen(DefaultTerminalWidth, 40, elapsed)
+
+			// Same inputs on a fresh model give the same frame
+			if again := newTestAppModel(t).RenderVirtualScreen(DefaultTerminalWidth, 40, elapsed); again != screen {
+				t.Fatalf("frame is not deterministic:\n%s\n---\n%s", screen, again)
+			}
+			assertGolden(t, tt.golden, screen)
+		})
+	}
+}
+
+func TestFitToScreen(t *testing.T) {
+	if got := fitToScreen("a\nb\nc\n", 2); got != "a\nb" {
+		t.Errorf("clipped = %q, want %q", got, "a\nb")
+	}
+	if got := fitToScreen("a\n", 3); got != "a\n\n" {
+		t.Errorf("padded = %q, want %q", got, "a\n\n")
+	}
+}
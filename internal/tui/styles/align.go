@@ -0,0 +1,29 @@
+package styles
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// VisibleWidth returns the number of terminal columns s occupies, ignoring
+// ANSI color codes
+func VisibleWidth(s string) int {
+	return runewidth.StringWidth(StripANSI(s))
+}
+
+// GapWidth returns how many columns separate left and right when right is
+// aligned to width, never less than one
+func GapWidth(left, right string, width int) int {
+	gap := width - VisibleWidth(left) - VisibleWidth(right)
+	if gap < 1 {
+		return 1
+	}
+	return gap
+}
+
+// PadBetween joins left and right with spaces so right ends at column width.
+// When the two don't fit they are separated by a single space.
+func PadBetween(left, right string, width int) string {
+	return left + strings.Repeat(" ", GapWidth(left, right, width)) + right
+}
@@ -0,0 +1,67 @@
+package styles
+
+import "testing"
+
+func TestPadBetween(t *testing.T) {
+	const green, reset = "\x1b[32m", "\x1b[0m"
+
+	tests := []struct {
+		name        string
+		left, right string
+		width       int
+		want        string
+	}{
+		{name: "fits", left: "Step", right: "50%", width: 12, want: "Step     50%"},
+		{name: "exact fit", left: "Step", right: "50%", width: 8, want: "Step 50%"},
+		{name: "too narrow", left: "Step", right: "50%", width: 4, want: "Step 50%"},
+		{name: "zero width", left: "Step", right: "50%", width: 0, want: "Step 50%"},
+		{name: "colored", left: green + "Step" + reset, right: "50%", width: 12, want: green + "Step" + reset + "     50%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PadBetween(tt.left, tt.right, tt.width); got != tt.want {
+				t.Errorf("PadBetween(%q, %q, %d) = %q, want %q", tt.left, tt.right, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGapWidthNeverBelowOne(t *testing.T) {
+	for _, width := range []int{-5, 0, 3, 7} {
+		if got := GapWidth("Step", "50%", width); got != 1 {
+			t.Errorf("GapWidth() at width %d = %d, want 1", width, got)
+		}
+	}
+}
+
+func TestVisibleWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{name: "plain", s: "Installing", want: 10},
+		{name: "colored", s: "\x1b[1;32mInstalling\x1b[0m", want: 10},
+		{name: "true color", s: "\x1b[38;2;255;100;0m42%\x1b[0m", want: 3},
+		{name: "CJK", s: "日本語", want: 6},
+		{name: "colored CJK", s: "\x1b[33m安装依赖\x1b[0m done", want: 13},
+		{name: "escapes only", s: "\x1b[0m\x1b[32m", want: 0},
+		{name: "empty", s: "", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VisibleWidth(tt.s); got != tt.want {
+				t.Errorf("VisibleWidth(%q) = %d, want %d", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPadBetweenAlignsWideRunes(t *testing.T) {
+	got := PadBetween("\x1b[33m日本語\x1b[0m", "50%", 12)
+	if width := VisibleWidth(got); width != 12 {
+		t.Errorf("PadBetween() with CJK text is %d columns wide, want 12: %q", width, got)
+	}
+}
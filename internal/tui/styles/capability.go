@@ -0,0 +1,109 @@
+package styles
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"golang.org/x/term"
+)
+
+// ColorMode controls whether ANSI colors are emitted
+type ColorMode int
+
+const (
+	ColorAuto   ColorMode = iota // Detect from NO_COLOR, TERM and TTY
+	ColorAlways                  // Always emit colors
+	ColorNever                   // Never emit colors
+)
+
+// String returns the string representation of the color mode
+func (c ColorMode) String() string {
+	switch c {
+	case ColorAuto:
+		return "auto"
+	case ColorAlways:
+		return "always"
+	case ColorNever:
+		return "never"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseColorMode parses the --color flag value
+func ParseColorMode(s string) (ColorMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "auto", "":
+		return ColorAuto, nil
+	case "always":
+		return ColorAlways, nil
+	case "never":
+		return ColorNever, nil
+	default:
+		return ColorAuto, fmt.Errorf("invalid color mode: %s (expected auto, always or never)", s)
+	}
+}
+
+// ColorEnabled is the single place that decides whether output should be colored.
+// In auto mode colors are disabled for NO_COLOR, TERM=dumb and non-TTY output;
+// FORCE_COLOR (any value but "0" or "false") overrides the TERM and TTY checks.
+func ColorEnabled(mode ColorMode, out *os.File) bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+
+	if _, set := os.LookupEnv("NO_COLOR"); set {
+		return false
+	}
+	if forceColor() {
+		return true
+	}
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	if out == nil {
+		return false
+	}
+	return term.IsTerminal(int(out.Fd()))
+}
+
+// forceColor reports whether FORCE_COLOR asks for colors regardless of the terminal
+func forceColor() bool {
+	value, set := os.LookupEnv("FORCE_COLOR")
+	if !set {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "0", "false":
+		return false
+	default:
+		return true
+	}
+}
+
+// ApplyColorMode configures lipgloss styles to match the color decision
+func ApplyColorMode(enabled bool) {
+	if !enabled {
+		lipgloss.SetColorProfile(termenv.Ascii)
+		return
+	}
+	// Forced colors on a non-TTY would otherwise be detected as plain ASCII
+	if lipgloss.ColorProfile() == termenv.Ascii {
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	}
+}
+
+// ansiPattern matches ANSI escape sequences
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+
+// StripANSI removes ANSI escape sequences from s
+func StripANSI(s string) string {
+	return ansiPattern.ReplaceAllString(s, "")
+}
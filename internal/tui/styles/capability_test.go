@@ -0,0 +1,98 @@
+package styles
+
+import (
+	"os"
+	"testing"
+)
+
+// unsetEnv removes key for the duration of the test
+func unsetEnv(t *testing.T, key string) {
+	t.Helper()
+	t.Setenv(key, "")
+	os.Unsetenv(key)
+}
+
+// pipeWriter returns the write end of a pipe, which is never a terminal
+func pipeWriter(t *testing.T) *os.File {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe() error = %v", err)
+	}
+	t.Cleanup(func() {
+		r.Close()
+		w.Close()
+	})
+	return w
+}
+
+func TestParseColorMode(t *testing.T) {
+	tests := map[string]ColorMode{"": ColorAuto, "auto": ColorAuto, "Always": ColorAlways, "never": ColorNever}
+	for input, want := range tests {
+		got, err := ParseColorMode(input)
+		if err != nil || got != want {
+			t.Errorf("ParseColorMode(%q) = %v, %v; want %v", input, got, err, want)
+		}
+	}
+
+	if _, err := ParseColorMode("sometimes"); err == nil {
+		t.Error("ParseColorMode(\"sometimes\") error = nil, want an error")
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		mode ColorMode
+		env  map[string]string
+		want bool
+	}{
+		{name: "always ignores NO_COLOR", mode: ColorAlways, env: map[string]string{"NO_COLOR": "1"}, want: true},
+		{name: "never", mode: ColorNever, want: false},
+		{name: "auto on a non-TTY", mode: ColorAuto, env: map[string]string{"TERM": "xterm-256color"}, want: false},
+		{name: "auto with NO_COLOR", mode: ColorAuto, env: map[string]string{"NO_COLOR": ""}, want: false},
+		{name: "auto with TERM=dumb", mode: ColorAuto, env: map[string]string{"TERM": "dumb"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetEnv(t, "NO_COLOR")
+			unsetEnv(t, "FORCE_COLOR")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if got := ColorEnabled(tt.mode, pipeWriter(t)); got != tt.want {
+				t.Errorf("ColorEnabled(%s) = %t, want %t", tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForceColorOverridesTerminalChecks(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "non-TTY", env: map[string]string{"FORCE_COLOR": "1"}, want: true},
+		{name: "TERM=dumb", env: map[string]string{"FORCE_COLOR": "1", "TERM": "dumb"}, want: true},
+		{name: "NO_COLOR still wins", env: map[string]string{"FORCE_COLOR": "1", "NO_COLOR": "1"}, want: false},
+		{name: "FORCE_COLOR=0", env: map[string]string{"FORCE_COLOR": "0"}, want: false},
+		{name: "FORCE_COLOR=false", env: map[string]string{"FORCE_COLOR": "false"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetEnv(t, "NO_COLOR")
+			unsetEnv(t, "FORCE_COLOR")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			if got := ColorEnabled(ColorAuto, pipeWriter(t)); got != tt.want {
+				t.Errorf("ColorEnabled(auto) = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ var (
 
 	// Special colors
 	Highlight = lipgloss.Color("#FBBF24") // Amber-400
+	Caution   = lipgloss.Color("#F97316") // Orange-500
 	Muted     = Gray500
 	Border    = Gray300
 	Background = Gray100
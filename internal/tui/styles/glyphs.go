@@ -0,0 +1,72 @@
+package styles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GlyphMode selects the characters used for step and component status icons
+type GlyphMode int
+
+const (
+	GlyphUnicode GlyphMode = iota // Checkmarks and crosses
+	GlyphASCII                    // Plain ASCII for terminals without a checkmark
+)
+
+// String returns the string representation of the glyph mode
+func (g GlyphMode) String() string {
+	switch g {
+	case GlyphUnicode:
+		return "unicode"
+	case GlyphASCII:
+		return "ascii"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseGlyphMode parses the --glyphs flag value
+func ParseGlyphMode(s string) (GlyphMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "unicode", "":
+		return GlyphUnicode, nil
+	case "ascii":
+		return GlyphASCII, nil
+	default:
+		return GlyphUnicode, fmt.Errorf("invalid glyph set: %s (expected unicode or ascii)", s)
+	}
+}
+
+// Glyphs is the set of status icons shared by steps, components and checkboxes
+type Glyphs struct {
+	Pending  string // Queued or unselected
+	Running  string // Installing or in progress
+	Complete string // Installed, done or selected
+	Error    string // Failed
+	Check    string // Bare checkmark used in text, e.g. "✓ Done"
+}
+
+var (
+	unicodeGlyphs = Glyphs{Pending: "[ ]", Running: "[✓ ]", Complete: "[✓]", Error: "[✗]", Check: "✓"}
+	asciiGlyphs   = Glyphs{Pending: "[ ]", Running: "[~]", Complete: "[x]", Error: "[!]", Check: "x"}
+
+	activeGlyphs = unicodeGlyphs
+)
+
+// GlyphsFor returns the glyph set for a mode
+func GlyphsFor(mode GlyphMode) Glyphs {
+	if mode == GlyphASCII {
+		return asciiGlyphs
+	}
+	return unicodeGlyphs
+}
+
+// ApplyGlyphMode selects the glyph set used by every renderer
+func ApplyGlyphMode(mode GlyphMode) {
+	activeGlyphs = GlyphsFor(mode)
+}
+
+// CurrentGlyphs returns the glyph set selected with ApplyGlyphMode
+func CurrentGlyphs() Glyphs {
+	return activeGlyphs
+}
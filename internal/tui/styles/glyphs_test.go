@@ -0,0 +1,42 @@
+package styles
+
+import "testing"
+
+func TestParseGlyphMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    GlyphMode
+		wantErr bool
+	}{
+		{"", GlyphUnicode, false},
+		{"unicode", GlyphUnicode, false},
+		{"ascii", GlyphASCII, false},
+		{" ASCII ", GlyphASCII, false},
+		{"emoji", GlyphUnicode, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseGlyphMode(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseGlyphMode(%q) error = %v, wantErr %t", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseGlyphMode(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestApplyGlyphModeSwitchesCurrentGlyphs(t *testing.T) {
+	t.Cleanup(func() { ApplyGlyphMode(GlyphUnicode) })
+
+	ApplyGlyphMode(GlyphASCII)
+	if got := CurrentGlyphs(); got != asciiGlyphs {
+		t.Errorf("CurrentGlyphs() after ApplyGlyphMode(ascii) = %+v, want %+v", got, asciiGlyphs)
+	}
+
+	ApplyGlyphMode(GlyphUnicode)
+	if got := CurrentGlyphs(); got != unicodeGlyphs {
+		t.Errorf("CurrentGlyphs() after ApplyGlyphMode(unicode) = %+v, want %+v", got, unicodeGlyphs)
+	}
+}
@@ -0,0 +1,25 @@
+package styles
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// DefaultSeparator is the rune used for separator lines and dash runs
+const DefaultSeparator = '-'
+
+// ParseSeparator validates a --separator value: exactly one rune that occupies
+// a single terminal column, so width math can count runes as columns
+func ParseSeparator(s string) (rune, error) {
+	if utf8.RuneCountInString(s) != 1 {
+		return 0, fmt.Errorf("separator must be a single character, got %q", s)
+	}
+
+	sep, _ := utf8.DecodeRuneInString(s)
+	if runewidth.RuneWidth(sep) != 1 {
+		return 0, fmt.Errorf("separator %q must have a display width of 1", s)
+	}
+	return sep, nil
+}
@@ -0,0 +1,30 @@
+package styles
+
+import "testing"
+
+func TestParseSeparator(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    rune
+		wantErr bool
+	}{
+		{value: "-", want: '-'},
+		{value: "─", want: '─'},
+		{value: "=", want: '='},
+		{value: "·", want: '·'},
+		{value: "", wantErr: true},
+		{value: "--", wantErr: true},
+		{value: "＝", wantErr: true}, // full-width, two columns
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSeparator(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSeparator(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSeparator(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package styles
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Theme is the ANSI palette shared by the progress table and the AAR, so a
+// palette change (e.g. for colorblind users) never touches rendering logic
+type Theme struct {
+	Reset string // Ends any color or style
+
+	Text      string // Headings and labels
+	Queued    string // Queued steps and components
+	Running   string // Running steps, installing components, TypeScript
+	Done      string // Completed steps, installed components, success
+	Failed    string // Failed steps and components
+	Warning   string // Stalling, paused, JavaScript, completed with errors
+	Skipped   string // Skipped components
+	Dashes    string // Separator lines and secondary text
+	AppName   string // App name, paths and commands
+	Highlight string // PRODUCTION READY
+
+	Bold            string
+	Italic          string
+	NormalIntensity string // Ends bold without resetting colors
+}
+
+// DefaultTheme returns the standard EngX palette
+func DefaultTheme() *Theme {
+	return &Theme{
+		Reset:           "\033[0m",
+		Text:            "\033[97m",       // Bright white
+		Queued:          "\033[97m",       // Bright white
+		Running:         "\033[94m",       // Blue
+		Done:            "\033[92m",       // Green
+		Failed:          "\033[91m",       // Red
+		Warning:         "\033[93m",       // Yellow
+		Skipped:         "\033[37m",       // Light grey
+		Dashes:          "\033[90m",       // Darker grey
+		AppName:         "\033[95m",       // Bright magenta
+		Highlight:       "\033[38;5;208m", // Bright orange
+		Bold:            "\033[1m",
+		Italic:          "\033[3m",
+		NormalIntensity: "\033[22m",
+	}
+}
+
+// MonochromeTheme returns a palette with no colors or styles at all
+func MonochromeTheme() *Theme {
+	return &Theme{}
+}
+
+// HighContrastTheme returns a colorblind-friendly palette that tells states
+// apart by blue/orange and brightness rather than red/green
+func HighContrastTheme() *Theme {
+	return &Theme{
+		Reset:           "\033[0m",
+		Text:            "\033[1;97m",       // Bold bright white
+		Queued:          "\033[97m",         // Bright white
+		Running:         "\033[96m",         // Bright cyan
+		Done:            "\033[1;94m",       // Bold bright blue
+		Failed:          "\033[1;38;5;208m", // Bold orange
+		Warning:         "\033[93m",         // Yellow
+		Skipped:         "\033[37m",         // Light grey
+		Dashes:          "\033[37m",         // Light grey, brighter than the default
+		AppName:         "\033[1;95m",       // Bold bright magenta
+		Highlight:       "\033[1;93m",       // Bold yellow
+		Bold:            "\033[1m",
+		Italic:          "\033[3m",
+		NormalIntensity: "\033[22m",
+	}
+}
+
+// ParseTheme parses the --theme flag value into its palette
+func ParseTheme(s string) (*Theme, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "default", "":
+		return DefaultTheme(), nil
+	case "high-contrast":
+		return HighContrastTheme(), nil
+	case "monochrome":
+		return MonochromeTheme(), nil
+	default:
+		return nil, fmt.Errorf("invalid theme: %s (expected default, high-contrast or monochrome)", s)
+	}
+}
@@ -0,0 +1,31 @@
+package styles
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTheme(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    *Theme
+		wantErr bool
+	}{
+		{"", DefaultTheme(), false},
+		{"default", DefaultTheme(), false},
+		{"High-Contrast", HighContrastTheme(), false},
+		{" monochrome ", MonochromeTheme(), false},
+		{"solarized", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTheme(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTheme(%q) error = %v, wantErr %t", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseTheme(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}